@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/scheduler"
+)
+
+const (
+	outputFormatShort = "short"
+	outputFormatYAML  = "yaml"
+	outputFormatJSON  = "json"
+)
+
+var (
+	flagAppID  string
+	flagSocket string
+)
+
+var ActorCmd = &cobra.Command{
+	Use:   "actor",
+	Short: "Actor commands. Invoke actor methods, read actor state and manage reminders through a Dapr sidecar. Supported platforms: Self-hosted",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if flagSocket != "" {
+			if runtime.GOOS == "windows" {
+				return errors.New("the unix-domain-socket option is not supported on Windows")
+			}
+			print.WarningStatusEvent(os.Stderr, "Unix domain sockets are currently a preview feature")
+		}
+		return nil
+	},
+}
+
+func init() {
+	ActorCmd.PersistentFlags().StringVarP(&flagAppID, "app-id", "a", "", "The app ID whose sidecar is used to reach the actors")
+	ActorCmd.PersistentFlags().StringVarP(&flagSocket, "unix-domain-socket", "u", "", "Path to a unix domain socket dir. If specified, Dapr API servers will use Unix Domain Sockets")
+	ActorCmd.MarkPersistentFlagRequired("app-id")
+}
+
+func outputFunc(cmd *cobra.Command) *string {
+	outputs := []string{
+		outputFormatShort,
+		outputFormatYAML,
+		outputFormatJSON,
+	}
+
+	var outputFormat string
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", outputFormatShort, fmt.Sprintf("Output format. One of %s",
+		strings.Join(outputs, ", ")),
+	)
+
+	pre := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(outputs, outputFormat) {
+			return errors.New("invalid value for --output. Supported values are " + strings.Join(outputs, ", "))
+		}
+
+		if pre != nil {
+			return pre(cmd, args)
+		}
+		return nil
+	}
+
+	return &outputFormat
+}
+
+// actorArgs validates the arguments of commands addressing a named reminder
+// of an actor. They are either the actor type, actor ID and name, or
+// a single scheduler key of the form 'actor/{actor type}/{actor id}/{name}'.
+func actorArgs(cmd *cobra.Command, args []string) error {
+	switch len(args) {
+	case 1:
+		_, _, _, err := scheduler.ParseActorReminderKey(args[0])
+		return err
+	case 3:
+		return nil
+	default:
+		return fmt.Errorf("accepts either '<actor type> <actor id> <name>' or 'actor/<actor type>/<actor id>/<name>', received %d argument(s)", len(args))
+	}
+}
+
+func parseActorArgs(args []string) (string, string, string, error) {
+	if len(args) == 1 {
+		return scheduler.ParseActorReminderKey(args[0])
+	}
+	return args[0], args[1], args[2], nil
+}
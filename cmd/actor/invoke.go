@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actor
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+)
+
+var (
+	invokeData     string
+	invokeDataFile string
+	invokeVerb     string
+)
+
+var InvokeCmd = &cobra.Command{
+	Use:   "invoke <actor type> <actor id> <method>",
+	Short: "Invoke a method on an actor.",
+	Args:  cobra.ExactArgs(3),
+	Example: `
+# Invoke the "deposit" method of actor "acc-1" of type "Account"
+dapr actor invoke -a myapp Account acc-1 deposit --data '{"amount":10}'
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := readData(invokeData, invokeDataFile)
+		if err != nil {
+			return err
+		}
+
+		response, err := standalone.NewClient().InvokeActor(flagAppID, args[0], args[1], args[2], data, invokeVerb, flagSocket)
+		if err != nil {
+			return fmt.Errorf("error invoking actor %s/%s: %w", args[0], args[1], err)
+		}
+
+		if response != "" {
+			fmt.Println(response)
+		}
+		print.SuccessStatusEvent(os.Stdout, "Actor invoked successfully")
+
+		return nil
+	},
+}
+
+func readData(data, dataFile string) ([]byte, error) {
+	if data != "" && dataFile != "" {
+		return nil, errors.New("only one of --data and --data-file allowed in the same command")
+	}
+
+	if dataFile != "" {
+		b, err := os.ReadFile(dataFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading payload from '%s': %w", dataFile, err)
+		}
+		return b, nil
+	}
+
+	if data != "" {
+		return []byte(data), nil
+	}
+
+	return nil, nil
+}
+
+func init() {
+	InvokeCmd.Flags().StringVarP(&invokeData, "data", "d", "", "The JSON serialized data string (optional)")
+	InvokeCmd.Flags().StringVarP(&invokeDataFile, "data-file", "f", "", "A file containing the JSON serialized data (optional)")
+	InvokeCmd.Flags().StringVarP(&invokeVerb, "verb", "v", http.MethodPost, "The HTTP verb to use")
+	ActorCmd.AddCommand(InvokeCmd)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actor
+
+import (
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
+)
+
+var listOutputFormat *string
+
+type listOutput struct {
+	AppID string `csv:"APP ID" json:"appId" yaml:"appId"`
+	Type  string `csv:"TYPE"   json:"type"  yaml:"type"`
+	Count int    `csv:"ACTIVE" json:"count" yaml:"count"`
+}
+
+var ListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the actor types hosted by an app and their active actor counts.",
+	Args:    cobra.NoArgs,
+	Example: `
+# List the actor types hosted by "myapp"
+dapr actor list -a myapp
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		types, err := standalone.NewClient().ActorTypes(flagAppID, flagSocket)
+		if err != nil {
+			return err
+		}
+
+		if len(types) == 0 {
+			print.FailureStatusEvent(os.Stderr, "No actor types registered by app ID %q", flagAppID)
+			return nil
+		}
+
+		list := make([]listOutput, 0, len(types))
+		for _, t := range types {
+			list = append(list, listOutput{
+				AppID: flagAppID,
+				Type:  t.Type,
+				Count: t.Count,
+			})
+		}
+
+		switch *listOutputFormat {
+		case outputFormatYAML:
+			return utils.PrintDetail(os.Stdout, "yaml", list)
+		case outputFormatJSON:
+			return utils.PrintDetail(os.Stdout, "json", list)
+		default:
+			table, err := gocsv.MarshalString(list)
+			if err != nil {
+				return err
+			}
+			utils.PrintTable(table)
+			return nil
+		}
+	},
+}
+
+func init() {
+	listOutputFormat = outputFunc(ListCmd)
+	ActorCmd.AddCommand(ListCmd)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actor
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/scheduler"
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
+)
+
+var (
+	reminderDueTime  string
+	reminderPeriod   string
+	reminderTTL      string
+	reminderData     string
+	reminderDataFile string
+
+	reminderGetOutputFormat *string
+)
+
+type reminderOutput struct {
+	Key     string `csv:"KEY"      json:"key"               yaml:"key"`
+	DueTime string `csv:"DUE TIME" json:"dueTime,omitempty" yaml:"dueTime,omitempty"`
+	Period  string `csv:"PERIOD"   json:"period,omitempty"  yaml:"period,omitempty"`
+	TTL     string `csv:"TTL"      json:"ttl,omitempty"     yaml:"ttl,omitempty"`
+	Data    string `csv:"DATA"     json:"data,omitempty"    yaml:"data,omitempty"`
+}
+
+var ReminderCmd = &cobra.Command{
+	Use:   "reminder",
+	Short: "Manage actor reminders.",
+	Long: `Manage actor reminders.
+Reminders are addressed either by actor type, actor ID and reminder name, or by
+the 'actor/{actor type}/{actor id}/{name}' key used by 'dapr scheduler'.
+`,
+}
+
+var ReminderCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create or update an actor reminder.",
+	Args:  actorArgs,
+	Example: `
+# Create a reminder which fires every 10 seconds, starting in 5 seconds
+dapr actor reminder create -a myapp Account acc-1 interest --due-time 5s --period 10s
+
+# Create the same reminder addressed by its scheduler key
+dapr actor reminder create -a myapp actor/Account/acc-1/interest --due-time 5s --period 10s --data '{"rate":2}'
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if reminderDueTime == "" && reminderPeriod == "" {
+			return errors.New("at least one of --due-time or --period is required")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		actorType, actorID, name, err := parseActorArgs(args)
+		if err != nil {
+			return err
+		}
+
+		data, err := readData(reminderData, reminderDataFile)
+		if err != nil {
+			return err
+		}
+
+		reminder := standalone.ActorReminder{
+			DueTime: reminderDueTime,
+			Period:  reminderPeriod,
+			TTL:     reminderTTL,
+		}
+		if data != nil {
+			if !json.Valid(data) {
+				return errors.New("reminder data must be valid JSON")
+			}
+			reminder.Data = data
+		}
+
+		if err = standalone.NewClient().CreateActorReminder(flagAppID, actorType, actorID, name, reminder, flagSocket); err != nil {
+			return err
+		}
+
+		print.SuccessStatusEvent(os.Stdout, "Reminder %s created", scheduler.ActorReminderKey(actorType, actorID, name))
+		return nil
+	},
+}
+
+var ReminderGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get an actor reminder.",
+	Args:  actorArgs,
+	Example: `
+dapr actor reminder get -a myapp Account acc-1 interest
+dapr actor reminder get -a myapp actor/Account/acc-1/interest -o json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		actorType, actorID, name, err := parseActorArgs(args)
+		if err != nil {
+			return err
+		}
+
+		reminder, err := standalone.NewClient().GetActorReminder(flagAppID, actorType, actorID, name, flagSocket)
+		if err != nil {
+			return err
+		}
+
+		out := []reminderOutput{{
+			Key:     scheduler.ActorReminderKey(actorType, actorID, name),
+			DueTime: reminder.DueTime,
+			Period:  reminder.Period,
+			TTL:     reminder.TTL,
+			Data:    string(reminder.Data),
+		}}
+
+		switch *reminderGetOutputFormat {
+		case outputFormatYAML:
+			return utils.PrintDetail(os.Stdout, "yaml", out)
+		case outputFormatJSON:
+			return utils.PrintDetail(os.Stdout, "json", out)
+		default:
+			table, err := gocsv.MarshalString(out)
+			if err != nil {
+				return err
+			}
+			utils.PrintTable(table)
+			return nil
+		}
+	},
+}
+
+var ReminderDeleteCmd = &cobra.Command{
+	Use:     "delete",
+	Aliases: []string{"d", "del"},
+	Short:   "Delete an actor reminder.",
+	Args:    actorArgs,
+	Example: `
+dapr actor reminder delete -a myapp Account acc-1 interest
+dapr actor reminder delete -a myapp actor/Account/acc-1/interest
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		actorType, actorID, name, err := parseActorArgs(args)
+		if err != nil {
+			return err
+		}
+
+		if err = standalone.NewClient().DeleteActorReminder(flagAppID, actorType, actorID, name, flagSocket); err != nil {
+			return err
+		}
+
+		print.SuccessStatusEvent(os.Stdout, "Reminder %s deleted", scheduler.ActorReminderKey(actorType, actorID, name))
+		return nil
+	},
+}
+
+func init() {
+	ReminderCreateCmd.Flags().StringVar(&reminderDueTime, "due-time", "", "Time after which the reminder is first invoked. Accepts a Go duration, RFC3339 timestamp or ISO 8601 duration")
+	ReminderCreateCmd.Flags().StringVar(&reminderPeriod, "period", "", "Interval between reminder invocations. Accepts a Go duration or ISO 8601 duration, optionally with a repetition count (e.g. R5/PT10S)")
+	ReminderCreateCmd.Flags().StringVar(&reminderTTL, "ttl", "", "Time after which the reminder expires. Accepts a Go duration, RFC3339 timestamp or ISO 8601 duration")
+	ReminderCreateCmd.Flags().StringVarP(&reminderData, "data", "d", "", "The JSON serialized data passed to the reminder callback (optional)")
+	ReminderCreateCmd.Flags().StringVarP(&reminderDataFile, "data-file", "f", "", "A file containing the JSON serialized reminder data (optional)")
+
+	reminderGetOutputFormat = outputFunc(ReminderGetCmd)
+
+	ReminderCmd.AddCommand(ReminderCreateCmd)
+	ReminderCmd.AddCommand(ReminderGetCmd)
+	ReminderCmd.AddCommand(ReminderDeleteCmd)
+	ActorCmd.AddCommand(ReminderCmd)
+}
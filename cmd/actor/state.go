@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actor
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/standalone"
+)
+
+var StateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Read actor state.",
+}
+
+var StateGetCmd = &cobra.Command{
+	Use:   "get <actor type> <actor id> <key>",
+	Short: "Get the value of a key of an actor's state.",
+	Args:  cobra.ExactArgs(3),
+	Example: `
+# Get the "balance" key of actor "acc-1" of type "Account"
+dapr actor state get -a myapp Account acc-1 balance
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		value, err := standalone.NewClient().GetActorState(flagAppID, args[0], args[1], args[2], flagSocket)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(value)
+		return nil
+	},
+}
+
+func init() {
+	StateCmd.AddCommand(StateGetCmd)
+	ActorCmd.AddCommand(StateCmd)
+}
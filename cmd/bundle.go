@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
+)
+
+var (
+	bundleRuntimeVersion   string
+	bundleOS               string
+	bundleArch             string
+	bundleOutput           string
+	bundleContainerRuntime string
+)
+
+var BundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Manage air-gap bundles installed with 'dapr init --from-dir'. Supported platforms: Self-hosted",
+}
+
+var BundleCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an air-gap bundle with the Dapr binaries and images",
+	Long: `Create an air-gap bundle with the daprd, placement, scheduler and sentry binaries, the CLI, and the Dapr, Redis and Zipkin images.
+The bundle can be copied to a machine without internet access and installed with 'dapr init --from-dir'.
+`,
+	Example: `
+# Create a bundle of the latest runtime for this machine in the daprbundle directory
+dapr bundle create
+
+# Create a bundle of a specific runtime version for linux/arm64 as a tar.gz file
+dapr bundle create --runtime-version 1.16.0 --os linux --arch arm64 --output daprbundle_linux_arm64.tar.gz
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !utils.IsValidContainerRuntime(bundleContainerRuntime) {
+			print.FailureStatusEvent(os.Stderr, "Invalid container runtime. Supported values are docker and podman.")
+			os.Exit(1)
+		}
+		verify, err := standalone.ParseVerifyMode(verifyMode)
+		if err != nil {
+			print.FailureStatusEvent(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+
+		err = standalone.CreateBundle(standalone.BundleOptions{
+			RuntimeVersion:   bundleRuntimeVersion,
+			CLIVersion:       cliVersion,
+			OS:               bundleOS,
+			Arch:             bundleArch,
+			ContainerRuntime: bundleContainerRuntime,
+			Output:           bundleOutput,
+			Verify:           verify,
+		})
+		if err != nil {
+			print.FailureStatusEvent(os.Stderr, "Failed to create bundle: %s", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	BundleCreateCmd.Flags().StringVar(&bundleRuntimeVersion, "runtime-version", "latest", "The version of the Dapr runtime to bundle, for example: 1.16.0")
+	BundleCreateCmd.Flags().StringVar(&bundleOS, "os", runtime.GOOS, "The operating system the bundle is installed on")
+	BundleCreateCmd.Flags().StringVar(&bundleArch, "arch", runtime.GOARCH, "The architecture the bundle is installed on")
+	BundleCreateCmd.Flags().StringVarP(&bundleOutput, "output", "o", "daprbundle", "The directory to write the bundle to, or a file ending in .tar.gz")
+	BundleCreateCmd.Flags().StringVar(&bundleContainerRuntime, "container-runtime", string(utils.DOCKER), "The container runtime used to save the images. Supported values are docker (default) and podman")
+	BundleCreateCmd.Flags().StringVar(&verifyMode, "verify", string(standalone.VerifyStrict), "How to verify the checksums and signatures of the binaries. Supported values are "+strings.Join(standalone.VerifyModes, ", "))
+	BundleCreateCmd.Flags().BoolP("help", "h", false, "Print this help message")
+
+	BundleCmd.AddCommand(BundleCreateCmd)
+	RootCmd.AddCommand(BundleCmd)
+}
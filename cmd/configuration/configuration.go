@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/configuration"
+)
+
+const (
+	outputFormatShort = "short"
+	outputFormatYAML  = "yaml"
+	outputFormatJSON  = "json"
+)
+
+var (
+	flagKubernetesMode bool
+	flagDaprNamespace  string
+	flagAppID          string
+	flagStoreName      string
+	flagMetadata       map[string]string
+)
+
+var ConfigurationCmd = &cobra.Command{
+	Use:   "configuration",
+	Short: "Read and subscribe to keys of a configuration store component through a Dapr sidecar. Use -k to target a Kubernetes Dapr cluster.",
+}
+
+func init() {
+	ConfigurationCmd.PersistentFlags().BoolVarP(&flagKubernetesMode, "kubernetes", "k", false, "Target a Kubernetes dapr installation")
+	ConfigurationCmd.PersistentFlags().StringVarP(&flagDaprNamespace, "namespace", "n", "default", "Namespace of the Dapr application")
+	ConfigurationCmd.PersistentFlags().StringVarP(&flagAppID, "app-id", "a", "", "The app ID whose sidecar is used to reach the configuration store")
+	ConfigurationCmd.PersistentFlags().StringVarP(&flagStoreName, "store", "s", "", "The name of the configuration store component")
+	ConfigurationCmd.PersistentFlags().StringToStringVarP(&flagMetadata, "metadata", "m", nil, "Metadata passed to the configuration store, e.g. --metadata key1=value1,key2=value2")
+	ConfigurationCmd.MarkPersistentFlagRequired("app-id")
+	ConfigurationCmd.MarkPersistentFlagRequired("store")
+}
+
+func options(keys []string) configuration.Options {
+	return configuration.Options{
+		KubernetesMode: flagKubernetesMode,
+		Namespace:      flagDaprNamespace,
+		AppID:          flagAppID,
+		StoreName:      flagStoreName,
+		Keys:           keys,
+		Metadata:       flagMetadata,
+	}
+}
+
+func outputFunc(cmd *cobra.Command) *string {
+	outputs := []string{
+		outputFormatShort,
+		outputFormatYAML,
+		outputFormatJSON,
+	}
+
+	var outputFormat string
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", outputFormatShort, fmt.Sprintf("Output format. One of %s",
+		strings.Join(outputs, ", ")),
+	)
+
+	pre := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(outputs, outputFormat) {
+			return errors.New("invalid value for --output. Supported values are " + strings.Join(outputs, ", "))
+		}
+
+		if pre != nil {
+			return pre(cmd, args)
+		}
+		return nil
+	}
+
+	return &outputFormat
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/configuration"
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/kit/signals"
+)
+
+var (
+	getOutputFormat *string
+)
+
+var GetCmd = &cobra.Command{
+	Use:     "get",
+	Aliases: []string{"g"},
+	Short:   "Get keys from a configuration store.",
+	Long: `Get keys from a configuration store component through the sidecar of the given app.
+Accepts multiple keys. All keys of the store are returned when no key is given.
+`,
+	Example: `
+# Get two keys from the "configstore" component through the sidecar of "myapp"
+dapr configuration get --app-id myapp --store configstore orderId1 orderId2
+
+# Get all keys of the configuration store in Kubernetes mode
+dapr configuration get -k --app-id myapp --store configstore -o json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		items, err := configuration.Get(ctx, options(args))
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			print.FailureStatusEvent(os.Stderr, "No configuration items found in store %q", flagStoreName)
+			return nil
+		}
+
+		switch *getOutputFormat {
+		case outputFormatYAML:
+			err = utils.PrintDetail(os.Stdout, "yaml", items)
+		case outputFormatJSON:
+			err = utils.PrintDetail(os.Stdout, "json", items)
+		default:
+			var table string
+			table, err = gocsv.MarshalString(items)
+			if err != nil {
+				break
+			}
+
+			utils.PrintTable(table)
+		}
+
+		return err
+	},
+}
+
+func init() {
+	getOutputFormat = outputFunc(GetCmd)
+	ConfigurationCmd.AddCommand(GetCmd)
+}
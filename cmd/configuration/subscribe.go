@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/configuration"
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/kit/signals"
+)
+
+var (
+	subscribeOutputFormat *string
+)
+
+var SubscribeCmd = &cobra.Command{
+	Use:     "subscribe",
+	Aliases: []string{"sub"},
+	Short:   "Subscribe to changes of keys in a configuration store.",
+	Long: `Subscribe to changes of keys in a configuration store component through the sidecar of the given app.
+Changed items are printed as they arrive until the command is interrupted.
+Accepts multiple keys. All keys of the store are watched when no key is given.
+JSON output prints one item per line.
+`,
+	Example: `
+# Watch two keys of the "configstore" component through the sidecar of "myapp"
+dapr configuration subscribe --app-id myapp --store configstore orderId1 orderId2
+
+# Watch all keys and print each change as a JSON line
+dapr configuration subscribe --app-id myapp --store configstore -o json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		var (
+			lock     sync.Mutex
+			printErr error
+		)
+
+		print.InfoStatusEvent(os.Stderr, "Subscribed to configuration store %q. Press Ctrl+C to stop.", flagStoreName)
+
+		err := configuration.Subscribe(ctx, options(args), func(items []*configuration.ItemOutput) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			if err := printItems(items); err != nil && printErr == nil {
+				printErr = err
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		return printErr
+	},
+}
+
+func printItems(items []*configuration.ItemOutput) error {
+	switch *subscribeOutputFormat {
+	case outputFormatJSON:
+		enc := json.NewEncoder(os.Stdout)
+		for _, item := range items {
+			if err := enc.Encode(item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case outputFormatYAML:
+		if err := utils.PrintDetail(os.Stdout, "yaml", items); err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stdout, "---")
+		return nil
+	default:
+		table, err := gocsv.MarshalString(items)
+		if err != nil {
+			return err
+		}
+		utils.PrintTable(table)
+		return nil
+	}
+}
+
+func init() {
+	subscribeOutputFormat = outputFunc(SubscribeCmd)
+	ConfigurationCmd.AddCommand(SubscribeCmd)
+}
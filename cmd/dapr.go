@@ -21,6 +21,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/dapr/cli/cmd/actor"
+	"github.com/dapr/cli/cmd/configuration"
 	"github.com/dapr/cli/cmd/runtime"
 	"github.com/dapr/cli/cmd/scheduler"
 	"github.com/dapr/cli/cmd/workflow"
@@ -111,6 +113,9 @@ func init() {
 	RootCmd.PersistentFlags().BoolVarP(&logAsJSON, "log-as-json", "", false, "Log output in JSON format")
 	runtime.Register(RootCmd)
 
+	RootCmd.AddCommand(actor.ActorCmd)
+	RootCmd.AddCommand(configuration.ConfigurationCmd)
 	RootCmd.AddCommand(scheduler.SchedulerCmd)
 	RootCmd.AddCommand(workflow.WorkflowCmd)
+	RootCmd.AddCommand(runtime.RuntimeCmd)
 }
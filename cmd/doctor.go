@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/doctor"
+	"github.com/dapr/cli/pkg/kubernetes"
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
+)
+
+var (
+	doctorNetwork          string
+	doctorContainerRuntime string
+	doctorOutputFormat     string
+)
+
+var DoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the Dapr installation for common problems. Supported platforms: Kubernetes and self-hosted",
+	Example: `
+# Check the self-hosted installation
+dapr doctor
+
+# Check the self-hosted installation, which was initialized with a Docker network
+dapr doctor --network dapr-network
+
+# Check the installation in Kubernetes and print the results as JSON
+dapr doctor -k -o json
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if doctorOutputFormat != "" && doctorOutputFormat != "table" && doctorOutputFormat != "json" && doctorOutputFormat != "yaml" {
+			print.FailureStatusEvent(os.Stderr, "An invalid output format was specified. Supported values are table, json, yaml")
+			os.Exit(1)
+		}
+
+		var checks []doctor.Check
+		if kubernetesMode {
+			checks = kubernetes.Doctor()
+		} else {
+			wd, err := os.Getwd()
+			if err != nil {
+				print.FailureStatusEvent(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			checks = standalone.Doctor(standalone.DoctorOptions{
+				DaprInstallPath:  runtime.GetDaprRuntimePath(),
+				DockerNetwork:    doctorNetwork,
+				ContainerRuntime: doctorContainerRuntime,
+				CLIVersion:       daprVer.CliVersion,
+				LogsDir:          standalone.GetDaprLogsPath(wd),
+			})
+		}
+
+		var err error
+		if doctorOutputFormat == "json" || doctorOutputFormat == "yaml" {
+			err = utils.PrintDetail(os.Stdout, doctorOutputFormat, checks)
+		} else {
+			var table string
+			table, err = gocsv.MarshalString(checks)
+			if err == nil {
+				utils.PrintTable(table)
+			}
+		}
+		if err != nil {
+			print.FailureStatusEvent(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+
+		if doctor.Failed(checks) {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	DoctorCmd.Flags().BoolVarP(&kubernetesMode, "kubernetes", "k", false, "Check the Dapr installation in a Kubernetes cluster")
+	DoctorCmd.Flags().StringVarP(&doctorNetwork, "network", "", "", "The Docker network Dapr was initialized with")
+	DoctorCmd.Flags().StringVarP(&doctorContainerRuntime, "container-runtime", "", string(utils.DOCKER), "The container runtime to use. Supported values are docker (default) and podman")
+	DoctorCmd.Flags().StringVarP(&doctorOutputFormat, "output", "o", "", "The output format of the checks. Valid values are: json, yaml, or table (default)")
+	DoctorCmd.Flags().BoolP("help", "h", false, "Print this help message")
+	RootCmd.AddCommand(DoctorCmd)
+}
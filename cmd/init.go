@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/dapr/cli/cmd/runtime"
@@ -42,12 +43,18 @@ var (
 	enableMTLS                         bool
 	enableHA                           bool
 	values                             []string
+	valueFiles                         []string
 	fromDir                            string
 	containerRuntime                   string
 	imageVariant                       string
 	schedulerVolume                    string
 	schedulerOverrideBroadcastHostPort string
 	redisStack                         bool
+	verifyMode                         string
+	sentry                             bool
+	withAddons                         []string
+	initExport                         string
+	initDryRun                         bool
 )
 
 var InitCmd = &cobra.Command{
@@ -68,12 +75,18 @@ dapr init
 # Check docs or README for more information on the format of the image path that is required.
 dapr init --image-registry <registry-url>
 
+# Initialize Dapr in self-hosted mode, only warning when the checksums or signatures of the binaries cannot be verified
+dapr init --verify warn
+
 # Initialize Dapr in Kubernetes
 dapr init -k
 
 # Initialize Dapr in Kubernetes in dev mode
 dapr init -k --dev
 
+# Initialize Dapr in Kubernetes with a Helm values file, overriding one of its values
+dapr init -k --values values.yaml --set global.logAsJson=true
+
 # Initialize Dapr in Kubernetes and wait for the installation to complete (default timeout is 300s/5m)
 dapr init -k --wait --timeout 600
 
@@ -99,14 +112,41 @@ dapr init --runtime-path <path-to-install-directory>
 # Initialize Dapr in self-hosted mode with Redis Stack for RediSearch/vector store support
 dapr init --redis-stack
 
+# Initialize Dapr in self-hosted mode with the Sentry service and mTLS enabled
+dapr init --sentry
+
+# Initialize Dapr in self-hosted mode with Kafka and PostgreSQL, including their components
+dapr init --with kafka,postgres
+
+# List the add-ons which can be installed with --with
+dapr init addons list
+
+# Export the containers of a self-hosted installation as a Docker Compose file
+dapr init --export compose > docker-compose.yaml
+
+# Print the binaries, containers and files of a self-hosted installation without installing anything
+dapr init --dry-run
+
+# Print the Helm values and manifests of a Kubernetes installation without installing anything
+dapr init -k --dry-run
+
 # See more at: https://docs.dapr.io/getting-started/
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		print.PendingStatusEvent(os.Stdout, "Making the jump to hyperspace...")
+		if initExport != "" && initDryRun {
+			print.FailureStatusEvent(os.Stderr, "--export and --dry-run cannot be given at the same time")
+			os.Exit(1)
+		}
+		// The exported file and the plan are written to stdout, so nothing else is printed there.
+		if initExport == "" && !initDryRun {
+			print.PendingStatusEvent(os.Stdout, "Making the jump to hyperspace...")
+		}
 		imageRegistryFlag := strings.TrimSpace(viper.GetString("image-registry"))
 
 		if kubernetesMode {
-			print.InfoStatusEvent(os.Stdout, "Note: To install Dapr using Helm, see here: https://docs.dapr.io/getting-started/install-dapr-kubernetes/#install-with-helm-advanced\n")
+			if !initDryRun {
+				print.InfoStatusEvent(os.Stdout, "Note: To install Dapr using Helm, see here: https://docs.dapr.io/getting-started/install-dapr-kubernetes/#install-with-helm-advanced\n")
+			}
 			imageRegistryURI := ""
 			var err error
 
@@ -118,10 +158,26 @@ dapr init --redis-stack
 				print.FailureStatusEvent(os.Stderr, "--redis-stack is only valid for self-hosted mode")
 				os.Exit(1)
 			}
+			if sentry {
+				print.FailureStatusEvent(os.Stderr, "--sentry is only valid for self-hosted mode, use --enable-mtls in Kubernetes")
+				os.Exit(1)
+			}
+			if len(withAddons) != 0 {
+				print.FailureStatusEvent(os.Stderr, "--with is only valid for self-hosted mode")
+				os.Exit(1)
+			}
+			if initExport != "" {
+				print.FailureStatusEvent(os.Stderr, "--export is only valid for self-hosted mode")
+				os.Exit(1)
+			}
 
 			if len(imageRegistryFlag) != 0 {
-				warnForPrivateRegFeat()
+				if !initDryRun {
+					warnForPrivateRegFeat()
+				}
 				imageRegistryURI = imageRegistryFlag
+			} else if initDryRun {
+				imageRegistryURI, err = kubernetes.LookupImageRegistry()
 			} else {
 				imageRegistryURI, err = kubernetes.GetImageRegistry()
 			}
@@ -140,7 +196,9 @@ dapr init --redis-stack
 				EnableMTLS:                enableMTLS,
 				EnableHA:                  enableHA,
 				EnableDev:                 devMode,
+				ValueFiles:                valueFiles,
 				Args:                      values,
+				ChangedFlags:              changedFlags(cmd),
 				Wait:                      wait,
 				Timeout:                   timeout,
 				ImageRegistryURI:          imageRegistryURI,
@@ -149,6 +207,19 @@ dapr init --redis-stack
 				IssuerCertificateFilePath: strings.TrimSpace(issuerPublicCertificateFile),
 				IssuerPrivateKeyFilePath:  strings.TrimSpace(issuerPrivateKeyFile),
 			}
+			if initDryRun {
+				var releases []kubernetes.RenderedRelease
+				releases, err = kubernetes.DryRun(config)
+				if err == nil {
+					err = kubernetes.WriteReleases(os.Stdout, releases)
+				}
+				if err != nil {
+					print.FailureStatusEvent(os.Stderr, err.Error())
+					os.Exit(1)
+				}
+				return
+			}
+
 			err = kubernetes.Init(config)
 			if err != nil {
 				print.FailureStatusEvent(os.Stderr, err.Error())
@@ -156,6 +227,10 @@ dapr init --redis-stack
 			}
 			print.SuccessStatusEvent(os.Stdout, fmt.Sprintf("Success! Dapr has been installed to namespace %s. To verify, run `dapr status -k' in your terminal. To get started, go here: https://docs.dapr.io/getting-started", config.Namespace))
 		} else {
+			if len(valueFiles) != 0 {
+				print.FailureStatusEvent(os.Stderr, "--values is only valid for Kubernetes mode")
+				os.Exit(1)
+			}
 			dockerNetwork := ""
 			imageRegistryURI := ""
 			if !slimMode {
@@ -167,10 +242,10 @@ dapr init --redis-stack
 				print.FailureStatusEvent(os.Stderr, "both --image-registry and --from-dir flags cannot be given at the same time")
 				os.Exit(1)
 			}
-			if len(strings.TrimSpace(fromDir)) != 0 {
+			if len(strings.TrimSpace(fromDir)) != 0 && !initDryRun {
 				print.WarningStatusEvent(os.Stdout, "Local bundle installation using --from-dir flag is currently a preview feature and is subject to change. It is only available from CLI version 1.7 onwards.")
 			}
-			if len(imageRegistryURI) != 0 {
+			if len(imageRegistryURI) != 0 && initExport == "" && !initDryRun {
 				warnForPrivateRegFeat()
 			}
 
@@ -179,12 +254,18 @@ dapr init --redis-stack
 				os.Exit(1)
 			}
 
+			verify, err := standalone.ParseVerifyMode(verifyMode)
+			if err != nil {
+				print.FailureStatusEvent(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+
 			schedulerHostPort := &schedulerOverrideBroadcastHostPort
 			if schedulerOverrideBroadcastHostPort == "" {
 				schedulerHostPort = nil
 			}
 
-			err := standalone.Init(standalone.InitOptions{
+			opts := standalone.InitOptions{
 				RuntimeVersion:                     runtimeVersion,
 				DockerNetwork:                      dockerNetwork,
 				SlimMode:                           slimMode,
@@ -196,7 +277,32 @@ dapr init --redis-stack
 				SchedulerVolume:                    &schedulerVolume,
 				SchedulerOverrideBroadcastHostPort: schedulerHostPort,
 				RedisStack:                         redisStack,
-			})
+				Verify:                             verify,
+				Sentry:                             sentry,
+				Addons:                             withAddons,
+			}
+			if initExport != "" {
+				err = standalone.ExportInit(opts, initExport, os.Stdout)
+				if err != nil {
+					print.FailureStatusEvent(os.Stderr, err.Error())
+					os.Exit(1)
+				}
+				return
+			}
+			if initDryRun {
+				var plan *standalone.InitPlan
+				plan, err = standalone.PlanInit(opts)
+				if err == nil {
+					err = utils.PrintDetail(os.Stdout, "yaml", plan)
+				}
+				if err != nil {
+					print.FailureStatusEvent(os.Stderr, err.Error())
+					os.Exit(1)
+				}
+				return
+			}
+
+			err = standalone.Init(opts)
 			if err != nil {
 				print.FailureStatusEvent(os.Stderr, err.Error())
 				os.Exit(1)
@@ -206,6 +312,15 @@ dapr init --redis-stack
 	},
 }
 
+// changedFlags returns the names of the flags set on the command line.
+func changedFlags(cmd *cobra.Command) []string {
+	var names []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		names = append(names, f.Name)
+	})
+	return names
+}
+
 func verifyCustomCertFlags(cmd *cobra.Command) error {
 	ca := cmd.Flags().Lookup("ca-root-certificate")
 	issuerKey := cmd.Flags().Lookup("issuer-private-key")
@@ -246,8 +361,14 @@ func init() {
 	InitCmd.Flags().StringVarP(&schedulerVolume, "scheduler-volume", "", "dapr_scheduler", "Self-hosted only. Specify a volume for the scheduler service data directory.")
 	InitCmd.Flags().StringVarP(&schedulerOverrideBroadcastHostPort, "scheduler-override-broadcast-host-port", "", "", "Self-hosted only. Specify the scheduler broadcast host and port, for example: 192.168.42.42:50006. If not specified, it uses localhost:50006 (6060 for Windows).")
 	InitCmd.Flags().BoolVarP(&redisStack, "redis-stack", "", false, "Self-hosted only. Use redis-stack-server image instead of standard Redis for RediSearch support")
+	InitCmd.Flags().BoolVarP(&sentry, "sentry", "", false, "Self-hosted only. Install the Sentry service with a generated certificate chain and enable mTLS in the default configuration")
+	InitCmd.Flags().StringSliceVar(&withAddons, "with", nil, "Self-hosted only. The add-ons to install next to Redis and Zipkin, for example: kafka,postgres. Available add-ons are "+strings.Join(standalone.AddonNames(), ", "))
+	InitCmd.Flags().StringVar(&initExport, "export", "", "Self-hosted only. Write the containers which would be started to stdout instead of installing Dapr. Supported values are "+standalone.ExportCompose)
+	InitCmd.Flags().BoolVar(&initDryRun, "dry-run", false, "Print what would be installed instead of installing Dapr. In Kubernetes, print the Helm values and manifests")
+	InitCmd.Flags().StringVar(&verifyMode, "verify", string(standalone.VerifyStrict), "Self-hosted only. How to verify the checksums and signatures of the binaries. Supported values are "+strings.Join(standalone.VerifyModes, ", "))
 	InitCmd.Flags().BoolP("help", "h", false, "Print this help message")
 	InitCmd.Flags().StringArrayVar(&values, "set", []string{}, "set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+	InitCmd.Flags().StringSliceVar(&valueFiles, "values", []string{}, "Kubernetes only. Specify values in YAML files or URLs (can specify multiple). Values set with --set override them")
 	InitCmd.Flags().String("image-registry", "", "Custom/private docker image repository URL")
 	InitCmd.Flags().StringVarP(&containerRuntime, "container-runtime", "", defaultContainerRuntime, "The container runtime to use. Supported values are docker (default) and podman")
 	InitCmd.Flags().StringVarP(&caRootCertificateFile, "ca-root-certificate", "", "", "The root certificate file")
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
+)
+
+var (
+	addonsNetwork          string
+	addonsContainerRuntime string
+	addonsOutputFormat     string
+)
+
+var InitAddonsCmd = &cobra.Command{
+	Use:   "addons",
+	Short: "Manage the add-ons which can be installed with 'dapr init --with'. Supported platforms: Self-hosted",
+}
+
+var InitAddonsListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the available add-ons and whether they are running",
+	Example: `
+# List the available add-ons
+dapr init addons list
+
+# List the add-ons installed to a specific Docker network as JSON
+dapr init addons list --network dapr-network -o json
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if addonsOutputFormat != "" && addonsOutputFormat != "table" && addonsOutputFormat != "json" && addonsOutputFormat != "yaml" {
+			print.FailureStatusEvent(os.Stderr, "An invalid output format was specified. Supported values are table, json, yaml")
+			os.Exit(1)
+		}
+
+		list, err := standalone.ListAddons(addonsNetwork, addonsContainerRuntime)
+		if err != nil {
+			print.FailureStatusEvent(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+
+		if addonsOutputFormat == "json" || addonsOutputFormat == "yaml" {
+			err = utils.PrintDetail(os.Stdout, addonsOutputFormat, list)
+		} else {
+			var table string
+			table, err = gocsv.MarshalString(list)
+			if err == nil {
+				utils.PrintTable(table)
+			}
+		}
+		if err != nil {
+			print.FailureStatusEvent(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	InitAddonsListCmd.Flags().StringVarP(&addonsNetwork, "network", "", "", "The Docker network the add-ons are installed to")
+	InitAddonsListCmd.Flags().StringVarP(&addonsContainerRuntime, "container-runtime", "", string(utils.DOCKER), "The container runtime to use. Supported values are docker (default) and podman")
+	InitAddonsListCmd.Flags().StringVarP(&addonsOutputFormat, "output", "o", "", "The output format of the list. Valid values are: json, yaml, or table (default)")
+	InitAddonsListCmd.Flags().BoolP("help", "h", false, "Print this help message")
+	InitAddonsCmd.AddCommand(InitAddonsListCmd)
+	InitCmd.AddCommand(InitAddonsCmd)
+}
@@ -14,6 +14,7 @@ limitations under the License.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -21,21 +22,54 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/dapr/cli/cmd/runtime"
 	"github.com/dapr/cli/pkg/kubernetes"
 	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
 )
 
-var exportPath string
+var (
+	exportPath     string
+	mtlsSelfHosted bool
+)
 
 var MTLSCmd = &cobra.Command{
 	Use:   "mtls",
-	Short: "Check if mTLS is enabled. Supported platforms: Kubernetes",
+	Short: "Check if mTLS is enabled. Supported platforms: Kubernetes and self-hosted",
+	Long: `Check if mTLS is enabled. Supported platforms: Kubernetes and self-hosted.
+The mtls commands work on the Kubernetes cluster by default. Use --self-hosted
+for the self-hosted installation initialized with 'dapr init --sentry'.
+`,
 	Example: `
-# Check if mTLS is enabled
+# Check if mTLS is enabled in Kubernetes
 dapr mtls -k
+
+# Check if mTLS is enabled in self-hosted mode
+dapr mtls --self-hosted
 `,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if kubernetesMode && mtlsSelfHosted {
+			return errors.New("--kubernetes and --self-hosted cannot be used together")
+		}
+		// Kubernetes is the default, as it was before self-hosted
+		// installations were supported.
+		if !cmd.Flags().Changed("kubernetes") {
+			kubernetesMode = !mtlsSelfHosted
+		}
+		return nil
+	},
 	Run: func(cmd *cobra.Command, args []string) {
-		enabled, err := kubernetes.IsMTLSEnabled()
+		var (
+			enabled bool
+			err     error
+		)
+		platform := "self-hosted installation"
+		if kubernetesMode {
+			platform = "Kubernetes cluster"
+			enabled, err = kubernetes.IsMTLSEnabled()
+		} else {
+			enabled, err = standalone.IsMTLSEnabled(runtime.GetDaprRuntimePath())
+		}
 		if err != nil {
 			print.FailureStatusEvent(os.Stderr, fmt.Sprintf("error checking mTLS: %s", err))
 			os.Exit(1)
@@ -45,22 +79,30 @@ dapr mtls -k
 		if enabled {
 			status = "enabled"
 		}
-		fmt.Printf("Mutual TLS is %s in your Kubernetes cluster \n", status)
+		fmt.Printf("Mutual TLS is %s in your %s \n", status, platform)
 	},
 	PostRun: func(cmd *cobra.Command, args []string) {
-		kubernetes.CheckForCertExpiry()
+		checkForCertExpiry()
 	},
 }
 
 var ExportCMD = &cobra.Command{
 	Use:   "export",
-	Short: "Export the root CA, issuer cert and key from Kubernetes to local files",
+	Short: "Export the root CA, issuer cert and key from Kubernetes or a self-hosted installation to local files",
 	Example: `
-# Export certs to local folder 
+# Export certs from Kubernetes to local folder
 dapr mtls export -o ./certs
+
+# Export certs of a self-hosted installation to local folder
+dapr mtls export --self-hosted -o ./certs
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		err := kubernetes.ExportTrustChain(exportPath)
+		var err error
+		if kubernetesMode {
+			err = kubernetes.ExportTrustChain(exportPath)
+		} else {
+			err = standalone.ExportTrustChain(runtime.GetDaprRuntimePath(), exportPath)
+		}
 		if err != nil {
 			print.FailureStatusEvent(os.Stderr, fmt.Sprintf("error exporting trust chain certs: %s", err))
 			os.Exit(1)
@@ -70,7 +112,7 @@ dapr mtls export -o ./certs
 		print.SuccessStatusEvent(os.Stdout, "Trust certs successfully exported to "+dir)
 	},
 	PostRun: func(cmd *cobra.Command, args []string) {
-		kubernetes.CheckForCertExpiry()
+		checkForCertExpiry()
 	},
 }
 
@@ -80,9 +122,20 @@ var ExpiryCMD = &cobra.Command{
 	Example: `
 # Check expiry of Kubernetes certs
 dapr mtls expiry
+
+# Check expiry of self-hosted certs
+dapr mtls expiry --self-hosted
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		expiry, err := kubernetes.Expiry()
+		var (
+			expiry *time.Time
+			err    error
+		)
+		if kubernetesMode {
+			expiry, err = kubernetes.Expiry()
+		} else {
+			expiry, err = standalone.Expiry(runtime.GetDaprRuntimePath())
+		}
 		if err != nil {
 			print.FailureStatusEvent(os.Stderr, fmt.Sprintf("error getting root cert expiry: %s", err))
 			return
@@ -94,13 +147,21 @@ dapr mtls expiry
 }
 
 func init() {
-	MTLSCmd.Flags().BoolVarP(&kubernetesMode, "kubernetes", "k", false, "Check if mTLS is enabled in a Kubernetes cluster")
+	MTLSCmd.PersistentFlags().BoolVarP(&kubernetesMode, "kubernetes", "k", false, "Use the certificates of the Kubernetes cluster, which is the default")
+	MTLSCmd.PersistentFlags().BoolVar(&mtlsSelfHosted, "self-hosted", false, "Use the certificates of the self-hosted installation instead of the Kubernetes cluster")
 	MTLSCmd.Flags().BoolP("help", "h", false, "Print this help message")
 	ExportCMD.Flags().StringVarP(&exportPath, "out", "o", ".", "The output directory path to save the certs")
 	ExportCMD.Flags().BoolP("help", "h", false, "Print this help message")
-	MTLSCmd.MarkFlagRequired("kubernetes")
 	MTLSCmd.AddCommand(ExportCMD)
 	MTLSCmd.AddCommand(ExpiryCMD)
 	MTLSCmd.AddCommand(RenewCertificateCmd())
 	RootCmd.AddCommand(MTLSCmd)
 }
+
+func checkForCertExpiry() {
+	if kubernetesMode {
+		kubernetes.CheckForCertExpiry()
+	} else {
+		standalone.CheckForCertExpiry(runtime.GetDaprRuntimePath())
+	}
+}
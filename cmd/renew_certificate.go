@@ -23,8 +23,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/dapr/cli/cmd/runtime"
 	"github.com/dapr/cli/pkg/kubernetes"
 	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
 	"github.com/dapr/cli/utils"
 )
 
@@ -41,7 +43,7 @@ func RenewCertificateCmd() *cobra.Command {
 	command := &cobra.Command{
 		Use:     "renew-certificate",
 		Aliases: []string{"renew-cert", "rnc"},
-		Short:   "Rotates the Dapr root certificate on your Kubernetes cluster",
+		Short:   "Rotates the Dapr root certificate on your Kubernetes cluster or self-hosted installation",
 
 		Example: `
 # Generates new root and issuer certificates for kubernetes cluster
@@ -56,6 +58,9 @@ dapr mtls renew-certificate -k --ca-root-certificate <root.pem> --issuer-private
 # Generates new root and issuer certificates for kubernetes cluster with provided image variant
 dapr mtls renew-certificate -k --valid-until <no of days> --image-variant mariner --restart
 
+# Generates new root and issuer certificates for the self-hosted installation, valid for <no of days>
+dapr mtls renew-certificate --self-hosted --valid-until <no of days>
+
 # Use alias to renew certificate command
 dapr mtls rnc -k --valid-until <no of days> --restart
 dapr mtls renew-cert -k --valid-until <no of days> --restart
@@ -64,6 +69,11 @@ dapr mtls renew-cert -k --valid-until <no of days> --restart
 `,
 
 		Run: func(cmd *cobra.Command, args []string) {
+			// Rotating certificates is not defaulted to either platform.
+			if !cmd.Flags().Changed("kubernetes") && !mtlsSelfHosted {
+				logErrorAndExit(errors.New("specify --kubernetes or --self-hosted to select the certificates to renew"))
+			}
+
 			var err error
 			pkFlag := cmd.Flags().Lookup("private-key").Changed
 			rootcertFlag := cmd.Flags().Lookup("ca-root-certificate").Changed
@@ -121,9 +131,50 @@ dapr mtls renew-cert -k --valid-until <no of days> --restart
 						logErrorAndExit(err)
 					}
 				}
+			} else {
+				print.PendingStatusEvent(os.Stdout, "Starting certificate rotation")
+				params := standalone.RenewCertificateOptions{
+					DaprInstallPath: runtime.GetDaprRuntimePath(),
+					ValidUntil:      time.Hour * time.Duration(validUntil*24), //nolint:gosec
+				}
+				switch {
+				case rootcertFlag || issuerKeyFlag || issuerCertFlag:
+					if checkReqFlagArgsEmpty(caRootCertificateFile, issuerPrivateKeyFile, issuerPublicCertificateFile) {
+						logErrorAndExit(fmt.Errorf("all required flags for this certificate rotation path, %q, %q and %q are not present",
+							"ca-root-certificate", "issuer-private-key", "issuer-public-certificate"))
+					}
+					print.InfoStatusEvent(os.Stdout, "Using provided certificates")
+					params.RootCertificateFilePath = caRootCertificateFile
+					params.IssuerCertificateFilePath = issuerPublicCertificateFile
+					params.IssuerPrivateKeyFilePath = issuerPrivateKeyFile
+				case pkFlag:
+					if checkReqFlagArgsEmpty(privateKey) {
+						logErrorAndExit(fmt.Errorf("%q flag has incorrect value", "privateKey"))
+					}
+					print.InfoStatusEvent(os.Stdout, "Using password file to generate root certificate")
+					params.RootPrivateKeyFilePath = privateKey
+				default:
+					print.InfoStatusEvent(os.Stdout, "generating fresh certificates")
+				}
+				if err = standalone.RenewCertificate(params); err != nil {
+					logErrorAndExit(err)
+				}
 			}
 		},
 		PostRun: func(cmd *cobra.Command, args []string) {
+			if !kubernetesMode {
+				expiry, err := standalone.Expiry(runtime.GetDaprRuntimePath())
+				if err != nil {
+					logErrorAndExit(err)
+				}
+				print.SuccessStatusEvent(os.Stdout,
+					"Certificate rotation is successful! Your new certificate is valid through "+expiry.Format(time.RFC1123))
+				// Sentry reloads the certificates by itself, the sidecars only
+				// read the root certificate when they start.
+				print.InfoStatusEvent(os.Stdout, "Restart running applications so their sidecars trust the new root certificate.")
+				return
+			}
+
 			expiry, err := kubernetes.Expiry()
 			if err != nil {
 				logErrorAndExit(err)
@@ -147,10 +198,9 @@ dapr mtls renew-cert -k --valid-until <no of days> --restart
 	command.Flags().StringVarP(&issuerPrivateKeyFile, "issuer-private-key", "", "", "The issuer certificate private key")
 	command.Flags().StringVarP(&issuerPublicCertificateFile, "issuer-public-certificate", "", "", "The issuer certificate")
 	command.Flags().UintVarP(&validUntil, "valid-until", "", 365, "Max days before certificate expires")
-	command.Flags().BoolVarP(&restartDaprServices, "restart", "", false, "Restart Dapr control plane services")
+	command.Flags().BoolVarP(&restartDaprServices, "restart", "", false, "Restart Dapr control plane services. Kubernetes only")
 	command.Flags().UintVarP(&timeout, "timeout", "", 300, "The timeout for the certificate renewal")
 	command.Flags().StringVarP(&imageVariant, "image-variant", "", "", "The image variant to use for the Dapr runtime, for example: mariner")
-	command.MarkFlagRequired("kubernetes")
 	return command
 }
 
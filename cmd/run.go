@@ -76,6 +76,8 @@ var (
 	runFilePath          string
 	appChannelAddress    string
 	enableRunK8s         bool
+	runRuntimeVersion    string
+	runExport            string
 )
 
 const (
@@ -90,6 +92,7 @@ var runFileCompatibleFlags = []string{
 	"version",
 	"runtime-path",
 	"log-as-json",
+	"export",
 }
 
 var RunCmd = &cobra.Command{
@@ -121,6 +124,9 @@ dapr run --app-id myapp --app-port 3000 --app-channel-address localhost --app-pr
 # Run sidecar only specifying dapr runtime installation directory
 dapr run --app-id myapp --runtime-path /usr/local/dapr
 
+# Run with an installed runtime version other than the active one
+dapr run --app-id myapp --runtime-version 1.15.0 -- python myapp.py
+
 # Run multiple apps by providing path of a run config file
 dapr run --run-file dapr.yaml
 
@@ -130,6 +136,9 @@ dapr run --run-file /path/to/directory
 # Run multiple apps by providing config via stdin
 cat dapr.template.yaml | envsubst | dapr run --run-file -
 
+# Export the apps of a run config file as a Docker Compose file, to use with the file of "dapr init --export compose"
+dapr run --run-file dapr.yaml --export compose > apps.yaml
+
 # Run multiple apps in Kubernetes by providing a path of a run config file
 dapr run --run-file dapr.yaml -k
 
@@ -141,6 +150,22 @@ dapr run --run-file /path/to/directory -k
 		viper.BindPFlag("placement-host-address", cmd.Flags().Lookup("placement-host-address"))
 	},
 	Run: func(cmd *cobra.Command, args []string) {
+		if runExport != "" {
+			if len(runFilePath) == 0 || enableRunK8s {
+				print.FailureStatusEvent(os.Stderr, "--export is only valid with --run-file in self-hosted mode")
+				os.Exit(1)
+			}
+			runConfigFilePath, err := getRunFilePath(runFilePath)
+			if err != nil {
+				print.FailureStatusEvent(os.Stderr, "Failed to get run file path: %v", err)
+				os.Exit(1)
+			}
+			if err = exportRunFile(runConfigFilePath, runExport); err != nil {
+				print.FailureStatusEvent(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			return
+		}
 		if len(runFilePath) > 0 {
 			// Check for incompatible flags
 			incompatibleFlags := detectIncompatibleFlags(cmd)
@@ -210,7 +235,9 @@ dapr run --run-file /path/to/directory -k
 			EnableAPILogging:   enableAPILogging,
 			APIListenAddresses: apiListenAddresses,
 			DaprdInstallPath:   cmdruntime.GetDaprRuntimePath(),
+			RuntimeVersion:     runRuntimeVersion,
 		}
+		selectedVersion := selectedRuntimeVersion(runRuntimeVersion)
 
 		// placement-host-address flag handling: only set pointer if flag was explicitly changed
 		if cmd.Flags().Changed("placement-host-address") {
@@ -224,7 +251,7 @@ dapr run --run-file /path/to/directory -k
 			sharedRunConfig.SchedulerHostAddress = &val // may be empty => disable
 		} else {
 			// Apply version-based defaulting used previously
-			addr := validateSchedulerHostAddress(daprVer.RuntimeVersion, schedulerHostAddress)
+			addr := validateSchedulerHostAddress(selectedVersion, schedulerHostAddress)
 			if addr != "" {
 				sharedRunConfig.SchedulerHostAddress = &addr
 			}
@@ -271,7 +298,7 @@ dapr run --run-file /path/to/directory -k
 					output.DaprGRPCPort)
 			}
 
-			if (daprVer.RuntimeVersion != "edge") && (semver.Compare(fmt.Sprintf("v%v", daprVer.RuntimeVersion), "v1.14.0-rc.1") == -1) {
+			if (selectedVersion != "edge") && (semver.Compare(fmt.Sprintf("v%v", selectedVersion), "v1.14.0-rc.1") == -1) {
 				print.InfoStatusEvent(os.Stdout, "The scheduler is only compatible with dapr runtime 1.14 onwards.")
 				for i, arg := range output.DaprCMD.Args {
 					if strings.HasPrefix(arg, "--scheduler-host-address") {
@@ -507,6 +534,8 @@ func init() {
 	RunCmd.Flags().StringVar(&apiListenAddresses, "dapr-listen-addresses", "", "Comma separated list of IP addresses that sidecar will listen to")
 	RunCmd.Flags().StringVarP(&runFilePath, "run-file", "f", "", "Path to the run template file for the list of apps to run")
 	RunCmd.Flags().StringVarP(&appChannelAddress, "app-channel-address", "", utils.DefaultAppChannelAddress, "The network address the application listens on")
+	RunCmd.Flags().StringVar(&runRuntimeVersion, "runtime-version", "", "The installed runtime version to run, for example: 1.15.0. Defaults to the active runtime, see `dapr runtime list`")
+	RunCmd.Flags().StringVar(&runExport, "export", "", "Write the apps of the run file to stdout instead of running them. Supported values are "+standalone.ExportCompose)
 	RootCmd.AddCommand(RunCmd)
 }
 
@@ -534,7 +563,7 @@ func executeRun(runTemplateName, runFilePath string, apps []runfileconfig.App) (
 		if app.SchedulerHostAddress != nil {
 			schedIn = *app.SchedulerHostAddress
 		}
-		schedOut := validateSchedulerHostAddress(daprVer.RuntimeVersion, schedIn)
+		schedOut := validateSchedulerHostAddress(selectedRuntimeVersion(app.RuntimeVersion), schedIn)
 		if schedOut != "" {
 			app.SchedulerHostAddress = &schedOut
 		}
@@ -696,6 +725,47 @@ func executeRunWithAppsConfigFile(runFilePath string, k8sEnabled bool) {
 	}
 }
 
+// exportRunFile writes the apps of a run file in the given format to stdout.
+func exportRunFile(runFilePath, format string) error {
+	if format != standalone.ExportCompose {
+		return fmt.Errorf("unsupported export format %q. Supported formats are %s", format, standalone.ExportCompose)
+	}
+	_, apps, err := getRunConfigFromRunFile(runFilePath)
+	if err != nil {
+		return fmt.Errorf("error getting apps from config file: %w", err)
+	}
+	if len(apps) == 0 {
+		return errors.New("no apps to export")
+	}
+
+	for i := range apps {
+		version := selectedRuntimeVersion(apps[i].RuntimeVersion)
+		if !semver.IsValid("v" + version) {
+			return fmt.Errorf("unknown runtime version %q for app %q. Install Dapr or set runtimeVersion in the run file", version, apps[i].AppID)
+		}
+		apps[i].RuntimeVersion = version
+
+		// Match the scheduler address `dapr run -f` would use.
+		var schedIn string
+		if apps[i].SchedulerHostAddress != nil {
+			schedIn = *apps[i].SchedulerHostAddress
+		}
+		if schedOut := validateSchedulerHostAddress(version, schedIn); schedOut != "" {
+			apps[i].SchedulerHostAddress = &schedOut
+		}
+	}
+	return runfileconfig.ExportCompose(apps, os.Stdout)
+}
+
+// selectedRuntimeVersion returns the version of the runtime an app is run
+// with, which is the active runtime unless a version was given.
+func selectedRuntimeVersion(version string) string {
+	if version = strings.TrimSpace(version); version != "" {
+		return strings.TrimPrefix(version, "v")
+	}
+	return daprVer.RuntimeVersion
+}
+
 // populate the scheduler host address based on the dapr version.
 func validateSchedulerHostAddress(version, address string) string {
 	// If no SchedulerHostAddress is supplied, set it to default value.
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+)
+
+var installVerifyMode string
+
+var InstallCmd = &cobra.Command{
+	Use:   "install [version]",
+	Short: "Install a runtime version next to the installed ones.",
+	Long: `Install the daprd binary of a runtime version next to the installed ones.
+The active runtime and the placement and scheduler services are not changed, use 'dapr runtime use' to make the version the active runtime.
+`,
+	Example: `
+# Install runtime version 1.15.0
+dapr runtime install 1.15.0
+
+# Install the latest runtime version
+dapr runtime install latest
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		verify, err := standalone.ParseVerifyMode(installVerifyMode)
+		if err != nil {
+			return err
+		}
+
+		version, err := standalone.InstallRuntime(GetDaprRuntimePath(), args[0], verify)
+		if err != nil {
+			return err
+		}
+
+		print.SuccessStatusEvent(os.Stdout, "Runtime version %s is installed. Run `dapr runtime use %s` to make it the active runtime.", version, version)
+		return nil
+	},
+}
+
+func init() {
+	InstallCmd.Flags().StringVar(&installVerifyMode, "verify", string(standalone.VerifyStrict), "How to verify the checksums and signatures of the binary. Supported values are "+strings.Join(standalone.VerifyModes, ", "))
+	RuntimeCmd.AddCommand(InstallCmd)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
+)
+
+var listOutputFormat string
+
+var ListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the installed runtime versions.",
+	Example: `
+# List the installed runtime versions
+dapr runtime list
+
+# List the installed runtime versions as JSON
+dapr runtime list -o json
+`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch listOutputFormat {
+		case "", "table", "json", "yaml":
+			return nil
+		default:
+			return errors.New("invalid value for --output. Supported values are table, json, yaml")
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		list, err := standalone.ListRuntimes(GetDaprRuntimePath())
+		if err != nil {
+			return err
+		}
+
+		if listOutputFormat == "json" || listOutputFormat == "yaml" {
+			return utils.PrintDetail(os.Stdout, listOutputFormat, list)
+		}
+
+		if len(list) == 0 {
+			fmt.Println("No runtime versions installed. Run `dapr init` to install the runtime.")
+			return nil
+		}
+
+		table, err := gocsv.MarshalString(list)
+		if err != nil {
+			return err
+		}
+		utils.PrintTable(table)
+		return nil
+	},
+}
+
+func init() {
+	ListCmd.Flags().StringVarP(&listOutputFormat, "output", "o", "", "The output format of the list. Valid values are: json, yaml, or table (default)")
+	RuntimeCmd.AddCommand(ListCmd)
+}
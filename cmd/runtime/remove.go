@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+)
+
+var RemoveCmd = &cobra.Command{
+	Use:     "remove [version]...",
+	Aliases: []string{"rm"},
+	Short:   "Remove installed runtime versions. The active runtime cannot be removed.",
+	Example: `
+# Remove runtime version 1.14.4
+dapr runtime remove 1.14.4
+`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, version := range args {
+			if err := standalone.RemoveRuntime(GetDaprRuntimePath(), version); err != nil {
+				return err
+			}
+			print.SuccessStatusEvent(os.Stdout, "Runtime version %s removed.", version)
+		}
+		return nil
+	},
+}
+
+func init() {
+	RuntimeCmd.AddCommand(RemoveCmd)
+}
@@ -19,6 +19,15 @@ var (
 	daprRuntimePath string
 )
 
+var RuntimeCmd = &cobra.Command{
+	Use:   "runtime",
+	Short: "Manage the runtime versions installed side by side. Supported platforms: Self-hosted",
+	Long: `Manage the runtime versions installed side by side.
+Every runtime version installed by 'dapr init', 'dapr upgrade' or 'dapr runtime install' is kept in its own directory.
+The active runtime is used by 'dapr run' unless --runtime-version, or runtimeVersion in the run template, selects another one.
+`,
+}
+
 func Register(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&daprRuntimePath, "runtime-path", "", "", "The path to the dapr runtime installation directory")
 }
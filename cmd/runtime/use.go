@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+)
+
+var UseCmd = &cobra.Command{
+	Use:   "use [version]",
+	Short: "Make an installed runtime version the active runtime.",
+	Long: `Make an installed runtime version the active runtime.
+The placement and scheduler services are not changed, use 'dapr upgrade' to change their version.
+`,
+	Example: `
+# Run apps with runtime version 1.15.0 by default
+dapr runtime use 1.15.0
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := standalone.UseRuntime(GetDaprRuntimePath(), args[0]); err != nil {
+			return err
+		}
+
+		print.SuccessStatusEvent(os.Stdout, "Runtime version %s is now the active runtime.", args[0])
+		return nil
+	},
+}
+
+func init() {
+	RuntimeCmd.AddCommand(UseCmd)
+}
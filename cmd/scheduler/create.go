@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/scheduler"
+	"github.com/dapr/kit/ptr"
+	"github.com/dapr/kit/signals"
+)
+
+var (
+	createSchedule  string
+	createDueTime   string
+	createRepeats   uint32
+	createTTL       string
+	createData      string
+	createOverwrite bool
+	createFile      string
+)
+
+var CreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"c"},
+	Short:   "Create or upsert app jobs in Scheduler.",
+	Long: `Create or upsert app jobs in Scheduler.
+Jobs are created through the Jobs API of the sidecar of the target app, so the app must be running.
+Job names are formatted as app/{app ID}/{job name}.
+Multiple jobs can be created from a YAML file containing a list of job definitions with the fields
+name, schedule, dueTime, repeats, ttl, data and overwrite.
+`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("file") {
+			if len(args) > 0 {
+				return errors.New("no arguments are accepted when using --file")
+			}
+			return nil
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	Example: `
+dapr scheduler create app/my-app-id/my-job-name --schedule "@every 10s" --data '{"key":"value"}'
+dapr scheduler create app/my-app-id/my-job-name --due-time 1h --repeats 3 --overwrite
+dapr scheduler create -f jobs.yaml
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+		opts := scheduler.CreateOptions{
+			DaprNamespace:  daprNamespace,
+			KubernetesMode: kubernetesMode,
+		}
+
+		if cmd.Flags().Changed("file") {
+			jobs, err := scheduler.ReadJobDefinitions(createFile)
+			if err != nil {
+				return err
+			}
+			if createOverwrite {
+				for i := range jobs {
+					jobs[i].Overwrite = true
+				}
+			}
+			return scheduler.Create(ctx, opts, jobs...)
+		}
+
+		job := scheduler.JobDefinition{
+			Name:      args[0],
+			Overwrite: createOverwrite,
+		}
+		if cmd.Flags().Changed("schedule") {
+			job.Schedule = &createSchedule
+		}
+		if cmd.Flags().Changed("due-time") {
+			job.DueTime = &createDueTime
+		}
+		if cmd.Flags().Changed("repeats") {
+			job.Repeats = ptr.Of(createRepeats)
+		}
+		if cmd.Flags().Changed("ttl") {
+			job.TTL = &createTTL
+		}
+		if cmd.Flags().Changed("data") {
+			job.Data = json.RawMessage(createData)
+		}
+
+		return scheduler.Create(ctx, opts, job)
+	},
+}
+
+func init() {
+	CreateCmd.Flags().StringVar(&createSchedule, "schedule", "", "Schedule of the job as a cron expression or period string, e.g. '@every 10s' or '0 */5 * * * *'")
+	CreateCmd.Flags().StringVar(&createDueTime, "due-time", "", "Time the job is first triggered, as a Go duration or RFC3339 timestamp")
+	CreateCmd.Flags().Uint32Var(&createRepeats, "repeats", 0, "Number of times the job is triggered before it is deleted")
+	CreateCmd.Flags().StringVar(&createTTL, "ttl", "", "Time after which the job expires, as a Go duration or RFC3339 timestamp")
+	CreateCmd.Flags().StringVar(&createData, "data", "", "JSON payload sent to the app when the job is triggered")
+	CreateCmd.Flags().BoolVar(&createOverwrite, "overwrite", false, "Overwrite an existing job with the same name")
+	CreateCmd.Flags().StringVarP(&createFile, "file", "f", "", "YAML file containing a list of job definitions to create")
+	CreateCmd.MarkFlagFilename("file", "yaml", "yml", "json")
+	for _, f := range []string{"schedule", "due-time", "repeats", "ttl", "data"} {
+		CreateCmd.MarkFlagsMutuallyExclusive("file", f)
+	}
+	SchedulerCmd.AddCommand(CreateCmd)
+}
@@ -24,15 +24,19 @@ import (
 )
 
 var (
-	schedulerExportFile string
+	schedulerExportFile   string
+	schedulerExportFormat *string
 )
 
 var SchedulerExportCmd = &cobra.Command{
 	Use:   "export",
-	Short: "Export all jobs and actor reminders to a binary file, including the tracked count.",
+	Short: "Export all jobs and actor reminders to a file, including the tracked count.",
 	Long: `Export jobs and actor reminders which are scheduled in Scheduler.
 Can later be imported using 'dapr scheduler import'.
+The default binary format can be replaced with human-readable JSON or YAML
+using --format, which can be reviewed and edited before importing.
 dapr scheduler export -o output.bin
+dapr scheduler export -o output.yaml --format yaml
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
@@ -41,6 +45,7 @@ dapr scheduler export -o output.bin
 			SchedulerNamespace: schedulerNamespace,
 			KubernetesMode:     kubernetesMode,
 			TargetFile:         schedulerExportFile,
+			Format:             *schedulerExportFormat,
 		})
 		if err != nil {
 			return err
@@ -54,8 +59,9 @@ dapr scheduler export -o output.bin
 
 func init() {
 	SchedulerExportCmd.Flags().MarkHidden("namespace")
-	SchedulerExportCmd.Flags().StringVarP(&schedulerExportFile, "output-file", "o", "", "Output binary file to export jobs and actor reminders to.")
+	SchedulerExportCmd.Flags().StringVarP(&schedulerExportFile, "output-file", "o", "", "Output file to export jobs and actor reminders to.")
 	SchedulerExportCmd.MarkFlagRequired("output-file")
 	SchedulerExportCmd.MarkFlagFilename("output-file")
+	schedulerExportFormat = exportFormatFunc(SchedulerExportCmd)
 	SchedulerCmd.AddCommand(SchedulerExportCmd)
 }
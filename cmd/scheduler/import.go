@@ -24,27 +24,48 @@ import (
 )
 
 var (
-	schedulerImportFile string
+	schedulerImportFile         string
+	schedulerImportFormat       *string
+	schedulerImportFilter       *string
+	schedulerImportTargetNS     string
+	schedulerImportDryRun       bool
+	schedulerImportSkipExisting bool
 )
 
 var SchedulerImportCmd = &cobra.Command{
 	Use:   "import",
-	Short: "Import all jobs and actor reminders from a binary file generated by 'dapr scheduler export'.",
-	Long: `Import jobs and actor reminders to Scheduler from a binary file generated by 'dapr scheduler export'.
-dapr scheduler import -f export.bin`,
+	Short: "Import all jobs and actor reminders from a file generated by 'dapr scheduler export'.",
+	Long: `Import jobs and actor reminders to Scheduler from a file generated by 'dapr scheduler export'.
+Use --filter to import only jobs of a given type, --target-namespace to move all
+imported jobs into another namespace, and --skip-existing to leave jobs which
+already exist in Scheduler untouched. --dry-run shows what would be imported.
+dapr scheduler import -f export.bin
+dapr scheduler import -f export.yaml --format yaml --filter workflow --target-namespace staging --dry-run`,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
-		err := scheduler.Import(ctx, scheduler.ExportImportOptions{
+		opts := scheduler.ExportImportOptions{
 			SchedulerNamespace: schedulerNamespace,
 			KubernetesMode:     kubernetesMode,
 			TargetFile:         schedulerImportFile,
-		})
-		if err != nil {
+			Format:             *schedulerImportFormat,
+			Filter:             *schedulerImportFilter,
+			DryRun:             schedulerImportDryRun,
+			SkipExisting:       schedulerImportSkipExisting,
+		}
+		if cmd.Flags().Changed("target-namespace") {
+			opts.TargetNamespace = &schedulerImportTargetNS
+		}
+
+		if err := scheduler.Import(ctx, opts); err != nil {
 			return err
 		}
 
+		if schedulerImportDryRun {
+			return nil
+		}
+
 		print.InfoStatusEvent(os.Stdout, "Import from '%s' complete.", schedulerImportFile)
 
 		return nil
@@ -52,9 +73,13 @@ dapr scheduler import -f export.bin`,
 }
 
 func init() {
-	SchedulerImportCmd.Flags().MarkHidden("namespace")
 	SchedulerImportCmd.Flags().StringVarP(&schedulerImportFile, "input-file", "f", "", "Input file to import jobs and actor reminders from.")
 	SchedulerImportCmd.MarkFlagRequired("input-file")
 	SchedulerImportCmd.MarkFlagFilename("input-file")
+	SchedulerImportCmd.Flags().StringVar(&schedulerImportTargetNS, "target-namespace", "", "Move all imported jobs and actor reminders into this namespace")
+	SchedulerImportCmd.Flags().BoolVar(&schedulerImportDryRun, "dry-run", false, "Print the jobs and actor reminders which would be imported without importing them")
+	SchedulerImportCmd.Flags().BoolVar(&schedulerImportSkipExisting, "skip-existing", false, "Do not overwrite jobs and actor reminders which already exist in Scheduler")
+	schedulerImportFormat = exportFormatFunc(SchedulerImportCmd)
+	schedulerImportFilter = filterFunc(SchedulerImportCmd)
 	SchedulerCmd.AddCommand(SchedulerImportCmd)
 }
@@ -106,3 +106,30 @@ func filterFunc(cmd *cobra.Command) *string {
 
 	return &filterType
 }
+
+func exportFormatFunc(cmd *cobra.Command) *string {
+	all := []string{
+		scheduler.ExportFormatGob,
+		scheduler.ExportFormatJSON,
+		scheduler.ExportFormatYAML,
+	}
+
+	var format string
+	cmd.Flags().StringVar(&format, "format", scheduler.ExportFormatGob,
+		fmt.Sprintf("File format. Supported values are %s", strings.Join(all, ", ")),
+	)
+
+	pre := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(all, format) {
+			return errors.New("invalid value for --format. Supported values are " + strings.Join(all, ", "))
+		}
+
+		if pre != nil {
+			return pre(cmd, args)
+		}
+		return nil
+	}
+
+	return &format
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/scheduler"
+	"github.com/dapr/kit/ptr"
+	"github.com/dapr/kit/signals"
+)
+
+var (
+	watchFilterType   *string
+	watchOutputFormat string
+)
+
+const watchRowFormat = "%-20s  %-8s  %-10s  %-6s  %-20s  %s\n"
+
+var WatchCmd = &cobra.Command{
+	Use:     "watch",
+	Aliases: []string{"w"},
+	Short:   "Watch scheduled jobs in Scheduler for changes and triggers.",
+	Long: `Watch scheduled jobs in Scheduler for changes and triggers.
+Prints an event whenever a job is created, updated or deleted, and whenever a job is triggered, until interrupted.
+JSON output prints one event per line.
+`,
+	Args: cobra.NoArgs,
+	Example: `
+dapr scheduler watch
+dapr scheduler watch --filter actor
+dapr scheduler watch -k -o json
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		outputs := []string{outputFormatShort, outputFormatJSON}
+		if !slices.Contains(outputs, watchOutputFormat) {
+			return errors.New("invalid value for --output. Supported values are " + strings.Join(outputs, ", "))
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		opts := scheduler.WatchOptions{
+			SchedulerNamespace: schedulerNamespace,
+			KubernetesMode:     kubernetesMode,
+			Filter: scheduler.Filter{
+				Type:      *watchFilterType,
+				Namespace: ptr.Of(daprNamespace),
+			},
+		}
+
+		print.InfoStatusEvent(os.Stderr, "Watching jobs in namespace %q. Press Ctrl+C to stop.", daprNamespace)
+
+		var fn func(*scheduler.WatchEvent) error
+		if watchOutputFormat == outputFormatJSON {
+			enc := json.NewEncoder(os.Stdout)
+			fn = func(ev *scheduler.WatchEvent) error {
+				return enc.Encode(ev)
+			}
+		} else {
+			fmt.Fprintf(os.Stdout, watchRowFormat, "TIME", "EVENT", "NAMESPACE", "COUNT", "LAST TRIGGER", "NAME")
+			fn = func(ev *scheduler.WatchEvent) error {
+				return writeWatchRow(os.Stdout, ev)
+			}
+		}
+
+		err := scheduler.Watch(ctx, opts, fn)
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	},
+}
+
+func writeWatchRow(w io.Writer, ev *scheduler.WatchEvent) error {
+	lastTrigger := "-"
+	if ev.Job.LastTrigger != nil {
+		lastTrigger = ev.Job.LastTrigger.Format(time.RFC3339)
+	}
+
+	_, err := fmt.Fprintf(w, watchRowFormat,
+		ev.Time.Format(time.RFC3339),
+		ev.Type,
+		ev.Job.Namespace,
+		fmt.Sprintf("%d", ev.Job.Count),
+		lastTrigger,
+		ev.Job.Name,
+	)
+	return err
+}
+
+func init() {
+	watchFilterType = filterFunc(WatchCmd)
+	WatchCmd.Flags().StringVarP(&watchOutputFormat, "output", "o", outputFormatShort, fmt.Sprintf("Output format. One of %s, %s", outputFormatShort, outputFormatJSON))
+	SchedulerCmd.AddCommand(WatchCmd)
+}
@@ -24,14 +24,23 @@ import (
 	"github.com/dapr/cli/utils"
 )
 
+var statusDrift bool
+
 var StatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show the health status of Dapr services. Supported platforms: Kubernetes",
 	Example: `
 # Get status of Dapr services from Kubernetes
 dapr status -k 
+
+# Compare the Dapr control plane in Kubernetes with a Helm values file
+dapr status -k --drift --values values.yaml
 `,
 	Run: func(cmd *cobra.Command, args []string) {
+		if statusDrift != (len(valueFiles) != 0) {
+			print.FailureStatusEvent(os.Stderr, "--drift and --values must be given together")
+			os.Exit(1)
+		}
 		sc, err := kubernetes.NewStatusClient()
 		if err != nil {
 			print.FailureStatusEvent(os.Stderr, err.Error())
@@ -46,6 +55,10 @@ dapr status -k
 			print.FailureStatusEvent(os.Stderr, "No status returned. Is Dapr initialized in your cluster?")
 			os.Exit(1)
 		}
+		if statusDrift {
+			printDrift(status[0].Namespace)
+			return
+		}
 		table, err := gocsv.MarshalString(status)
 		if err != nil {
 			print.FailureStatusEvent(os.Stderr, err.Error())
@@ -59,8 +72,32 @@ dapr status -k
 	},
 }
 
+// printDrift prints the differences between the control plane and the
+// values files, and exits with an error when there are any.
+func printDrift(namespace string) {
+	drift, err := kubernetes.Drift(namespace, valueFiles)
+	if err != nil {
+		print.FailureStatusEvent(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	if len(drift) == 0 {
+		print.SuccessStatusEvent(os.Stdout, "The Dapr control plane in namespace %s matches the values", namespace)
+		return
+	}
+
+	table, err := gocsv.MarshalString(drift)
+	if err != nil {
+		print.FailureStatusEvent(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	utils.PrintTable(table)
+	os.Exit(1)
+}
+
 func init() {
 	StatusCmd.Flags().BoolVarP(&k8s, "kubernetes", "k", false, "Show the health status of Dapr services on Kubernetes cluster")
+	StatusCmd.Flags().BoolVar(&statusDrift, "drift", false, "Compare the Helm release values and the daprsystem configuration with the files given with --values")
+	StatusCmd.Flags().StringSliceVar(&valueFiles, "values", []string{}, "The Helm values files or URLs to compare the control plane with (can specify multiple)")
 	StatusCmd.Flags().BoolP("help", "h", false, "Print this help message")
 	StatusCmd.MarkFlagRequired("kubernetes")
 	RootCmd.AddCommand(StatusCmd)
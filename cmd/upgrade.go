@@ -20,25 +20,43 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	cmdruntime "github.com/dapr/cli/cmd/runtime"
 	"github.com/dapr/cli/pkg/kubernetes"
 	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/standalone"
+	"github.com/dapr/cli/utils"
 )
 
 var (
 	upgradeRuntimeVersion string
 	upgradeImageVariant   string
+
+	upgradeContainerRuntime string
 )
 
 var UpgradeCmd = &cobra.Command{
 	Use:   "upgrade",
-	Short: "Upgrades or downgrades a Dapr control plane installation in a cluster. Supported platforms: Kubernetes",
+	Short: "Upgrades or downgrades a Dapr installation. Supported platforms: Kubernetes and self-hosted",
 	PreRun: func(cmd *cobra.Command, args []string) {
 		viper.BindPFlag("image-registry", cmd.Flags().Lookup("image-registry"))
+		viper.BindPFlag("network", cmd.Flags().Lookup("network"))
 	},
 	Example: `
 # Upgrade Dapr in Kubernetes to the specified version
 dapr upgrade -k --runtime-version 1.16.0
 
+# Upgrade Dapr in Kubernetes with the values of a Helm values file
+dapr upgrade -k --runtime-version 1.16.0 --values values.yaml
+
+# Upgrade the self-hosted Dapr binaries and placement and scheduler containers
+dapr upgrade --runtime-version 1.16.0
+
+# Upgrade the self-hosted installation of a Docker network, when there are several
+dapr upgrade --runtime-version 1.16.0 --network mynet
+
+# Upgrade a self-hosted installation in a non-default install directory
+dapr upgrade --runtime-version 1.16.0 --runtime-path <path-to-install-directory>
+
 # See more at: https://docs.dapr.io/getting-started/
 `,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -46,6 +64,46 @@ dapr upgrade -k --runtime-version 1.16.0
 		imageRegistryURI := ""
 		var err error
 
+		if !kubernetesMode {
+			if len(valueFiles) != 0 {
+				print.FailureStatusEvent(os.Stderr, "--values is only valid for Kubernetes mode")
+				os.Exit(1)
+			}
+			if !utils.IsValidContainerRuntime(upgradeContainerRuntime) {
+				print.FailureStatusEvent(os.Stderr, "Invalid container runtime. Supported values are docker and podman.")
+				os.Exit(1)
+			}
+			if len(imageRegistryFlag) != 0 {
+				warnForPrivateRegFeat()
+			}
+			verify, verr := standalone.ParseVerifyMode(verifyMode)
+			if verr != nil {
+				print.FailureStatusEvent(os.Stderr, verr.Error())
+				os.Exit(1)
+			}
+			var version string
+			version, err = standalone.Upgrade(standalone.UpgradeOptions{
+				RuntimeVersion:   upgradeRuntimeVersion,
+				DockerNetwork:    viper.GetString("network"),
+				ContainerRuntime: upgradeContainerRuntime,
+				DaprInstallPath:  cmdruntime.GetDaprRuntimePath(),
+				ImageRegistryURL: imageRegistryFlag,
+				ImageVariant:     upgradeImageVariant,
+				Verify:           verify,
+			})
+			if err != nil {
+				print.FailureStatusEvent(os.Stderr, "Failed to upgrade Dapr: %s", err)
+				os.Exit(1)
+			}
+			print.SuccessStatusEvent(os.Stdout, "Dapr successfully upgraded to version %s. Restart your applications to pick up the new sidecar version.", version)
+			return
+		}
+
+		if len(strings.TrimSpace(cmdruntime.GetDaprRuntimePath())) != 0 {
+			print.FailureStatusEvent(os.Stderr, "--runtime-path is only valid for self-hosted mode")
+			os.Exit(1)
+		}
+
 		if len(imageRegistryFlag) != 0 {
 			warnForPrivateRegFeat()
 			imageRegistryURI = imageRegistryFlag
@@ -58,7 +116,9 @@ dapr upgrade -k --runtime-version 1.16.0
 		}
 		err = kubernetes.Upgrade(kubernetes.UpgradeConfig{
 			RuntimeVersion:   upgradeRuntimeVersion,
+			ValueFiles:       valueFiles,
 			Args:             values,
+			ChangedFlags:     changedFlags(cmd),
 			Timeout:          timeout,
 			ImageRegistryURI: imageRegistryURI,
 			ImageVariant:     upgradeImageVariant,
@@ -70,7 +130,9 @@ dapr upgrade -k --runtime-version 1.16.0
 		print.SuccessStatusEvent(os.Stdout, "Dapr control plane successfully upgraded to version %s. Make sure your deployments are restarted to pick up the latest sidecar version.", upgradeRuntimeVersion)
 	},
 	PostRun: func(cmd *cobra.Command, args []string) {
-		kubernetes.CheckForCertExpiry()
+		if kubernetesMode {
+			kubernetes.CheckForCertExpiry()
+		}
 	},
 }
 
@@ -78,13 +140,16 @@ func init() {
 	UpgradeCmd.Flags().BoolVarP(&kubernetesMode, "kubernetes", "k", false, "Upgrade or downgrade Dapr in a Kubernetes cluster")
 	UpgradeCmd.Flags().UintVarP(&timeout, "timeout", "", 300, "The timeout for the Kubernetes upgrade")
 	UpgradeCmd.Flags().StringVarP(&upgradeRuntimeVersion, "runtime-version", "", "", "The version of the Dapr runtime to upgrade or downgrade to, for example: 1.0.0")
+	UpgradeCmd.Flags().StringVar(&verifyMode, "verify", string(standalone.VerifyStrict), "Self-hosted only. How to verify the checksums and signatures of the binaries. Supported values are "+strings.Join(standalone.VerifyModes, ", "))
 	UpgradeCmd.Flags().BoolP("help", "h", false, "Print this help message")
 	UpgradeCmd.Flags().StringArrayVar(&values, "set", []string{}, "set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+	UpgradeCmd.Flags().StringSliceVar(&valueFiles, "values", []string{}, "Kubernetes only. Specify values in YAML files or URLs (can specify multiple). Values set with --set override them")
 	UpgradeCmd.Flags().String("image-registry", "", "Custom/Private docker image repository URL")
 	UpgradeCmd.Flags().StringVarP(&upgradeImageVariant, "image-variant", "", "", "The image variant to use for the Dapr runtime, for example: mariner")
+	UpgradeCmd.Flags().String("network", "", "The Docker network of the installation to upgrade. Defaults to the network of the placement container. Self-hosted only")
+	UpgradeCmd.Flags().StringVarP(&upgradeContainerRuntime, "container-runtime", "", "docker", "The container runtime to use. Supported values are docker (default) and podman. Self-hosted only")
 
 	UpgradeCmd.MarkFlagRequired("runtime-version")
-	UpgradeCmd.MarkFlagRequired("kubernetes")
 
 	RootCmd.AddCommand(UpgradeCmd)
 }
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/workflow"
+	"github.com/dapr/cli/utils"
+)
+
+type bulkFlag struct {
+	filter  *workflow.Filter
+	conn    *connFlag
+	idsFile string
+	dryRun  bool
+	yes     bool
+}
+
+// bulkCmd adds the flags selecting multiple workflow instances to cmd. The
+// instances are either given in a file or selected with the list filters.
+func bulkCmd(cmd *cobra.Command) *bulkFlag {
+	bflag := &bulkFlag{
+		filter: filterCmd(cmd),
+		conn:   connectionCmd(cmd),
+	}
+
+	cmd.Flags().StringVar(&bflag.idsFile, "instance-ids-file", "", "File with one workflow instance ID per line to operate on, or '-' to read from stdin")
+	cmd.Flags().BoolVar(&bflag.dryRun, "dry-run", false, "Print the workflow instances which would be affected without changing them")
+	cmd.Flags().BoolVar(&bflag.yes, "yes", false, "Do not ask for confirmation before changing multiple workflow instances")
+
+	for _, f := range []string{"filter-name", "filter-status", "filter-max-age"} {
+		cmd.MarkFlagsMutuallyExclusive("instance-ids-file", f)
+	}
+
+	return bflag
+}
+
+// enabled returns whether cmd was invoked for multiple instances.
+func (b *bulkFlag) enabled(cmd *cobra.Command) bool {
+	for _, f := range []string{"instance-ids-file", "filter-name", "filter-status", "filter-max-age"} {
+		if cmd.Flags().Changed(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// bulkArgs accepts a single instance argument, or none when instances are
+// selected with the bulk flags.
+func bulkArgs(bflag *bulkFlag) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if bflag.enabled(cmd) {
+			if len(args) > 0 {
+				return errors.New("no arguments are accepted when using --instance-ids-file or filter flags")
+			}
+			return nil
+		}
+		if cmd.Flags().Changed("dry-run") {
+			return errors.New("--dry-run requires --instance-ids-file or filter flags")
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	}
+}
+
+// runBulk applies op to all selected workflow instances, asking for
+// confirmation first, and prints a report of every instance.
+func runBulk(ctx context.Context, bflag *bulkFlag, appID, verb string, op workflow.BulkOp) error {
+	opts := workflow.BulkOptions{
+		KubernetesMode:   flagKubernetesMode,
+		Namespace:        flagDaprNamespace,
+		AppID:            appID,
+		ConnectionString: bflag.conn.connectionString,
+		TableName:        bflag.conn.tableName,
+		Filter:           *bflag.filter,
+	}
+
+	if bflag.idsFile != "" {
+		ids, err := readInstanceIDsFile(bflag.idsFile)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return fmt.Errorf("no workflow instance IDs found in %q", bflag.idsFile)
+		}
+		opts.InstanceIDs = ids
+	}
+
+	ids, err := workflow.BulkTargets(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		print.FailureStatusEvent(os.Stderr, "No workflow found in namespace %q for app ID %q", flagDaprNamespace, appID)
+		return nil
+	}
+
+	if bflag.dryRun {
+		for _, id := range ids {
+			fmt.Fprintln(os.Stdout, id)
+		}
+		print.InfoStatusEvent(os.Stderr, "Dry run: %d workflow instance(s) would be %s", len(ids), verb)
+		return nil
+	}
+
+	if !bflag.yes {
+		// Reading confirmation from stdin is not possible when the IDs are
+		// read from it too.
+		if bflag.idsFile == "-" {
+			return errors.New("--yes is required when reading instance IDs from stdin")
+		}
+
+		ok, err := confirmBulk(os.Stdin, os.Stdout,
+			fmt.Sprintf("%d workflow instance(s) of app %q will be %s. Continue?", len(ids), appID, verb))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			print.InfoStatusEvent(os.Stdout, "Aborted")
+			return nil
+		}
+	}
+
+	results, err := workflow.Bulk(ctx, opts, ids, op)
+	if err != nil {
+		return err
+	}
+
+	table, err := gocsv.MarshalString(results)
+	if err != nil {
+		return err
+	}
+	utils.PrintTable(table)
+
+	var failed int
+	for _, r := range results {
+		if r.Result != workflow.BulkResultSuccess {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d workflow instance(s) could not be %s", failed, len(results), verb)
+	}
+
+	print.SuccessStatusEvent(os.Stdout, "%d workflow instance(s) %s successfully", len(results), verb)
+	return nil
+}
+
+func readInstanceIDsFile(path string) ([]string, error) {
+	if path == "-" {
+		return workflow.ReadInstanceIDs(os.Stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return workflow.ReadInstanceIDs(f)
+}
+
+func confirmBulk(in io.Reader, out io.Writer, msg string) (bool, error) {
+	fmt.Fprintf(out, "%s [y/N]: ", msg)
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmBulk(t *testing.T) {
+	for input, expect := range map[string]bool{
+		"y\n":   true,
+		"YES\n": true,
+		" y ":   true,
+		"n\n":   false,
+		"\n":    false,
+		"":      false,
+		"nope":  false,
+	} {
+		var out bytes.Buffer
+		ok, err := confirmBulk(strings.NewReader(input), &out, "Continue?")
+		require.NoError(t, err)
+		assert.Equal(t, expect, ok, "input %q", input)
+		assert.Equal(t, "Continue? [y/N]: ", out.String())
+	}
+}
+
+func TestBulkCmdFlags(t *testing.T) {
+	for _, cmd := range []string{"terminate", "suspend", "resume", "raise-event"} {
+		t.Run(cmd, func(t *testing.T) {
+			c, _, err := WorkflowCmd.Find([]string{cmd})
+			require.NoError(t, err)
+			for _, f := range []string{"instance-ids-file", "dry-run", "yes", "filter-name", "filter-status", "filter-max-age"} {
+				assert.NotNil(t, c.Flags().Lookup(f), f)
+			}
+		})
+	}
+
+	// The arguments are validated on a fresh command, so no parsed flag
+	// values are left on the package commands for later tests.
+	newBulkCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Use:           "bulk",
+			SilenceErrors: true,
+			SilenceUsage:  true,
+			RunE:          func(*cobra.Command, []string) error { return nil },
+		}
+		cmd.Args = bulkArgs(bulkCmd(cmd))
+		return cmd
+	}
+
+	t.Run("argument and filter flags are exclusive", func(t *testing.T) {
+		cmd := newBulkCmd()
+		cmd.SetArgs([]string{"abc", "--filter-status", "RUNNING"})
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no arguments are accepted")
+	})
+
+	t.Run("instance ids file and filter flags are exclusive", func(t *testing.T) {
+		cmd := newBulkCmd()
+		cmd.SetArgs([]string{"--instance-ids-file", "ids.txt", "--filter-name", "wf"})
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "none of the others can be")
+	})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/workflow"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/kit/signals"
+)
+
+const outputFormatUnified = "unified"
+
+var flagDiffOutput string
+
+var DiffCmd = &cobra.Command{
+	Use:   "diff [instance ID A] [instance ID B]",
+	Short: "Compare the histories of two workflow instances.",
+	Long: `Compare the histories of two workflow instances, such as an instance and its rerun.
+Steps are aligned by their event ID and name. Activities, child workflows and events
+whose inputs or outputs differ are shown with both values, steps only present in one
+of the instances as removed or added, and differences in how long steps took as timing
+deltas.
+`,
+	Example: `
+dapr workflow diff 12345678 87654321 -a myapp
+dapr workflow diff 12345678 87654321 -a myapp -o json
+`,
+	Args: cobra.ExactArgs(2),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		switch flagDiffOutput {
+		case outputFormatUnified, outputFormatJSON:
+			return nil
+		default:
+			return fmt.Errorf("invalid value for --output. Supported values are %s, %s", outputFormatUnified, outputFormatJSON)
+		}
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		appID, err := getWorkflowAppID(cmd)
+		if err != nil {
+			return err
+		}
+
+		diff, err := workflow.Diff(ctx, workflow.DiffOptions{
+			KubernetesMode: flagKubernetesMode,
+			Namespace:      flagDaprNamespace,
+			AppID:          appID,
+			InstanceIDA:    args[0],
+			InstanceIDB:    args[1],
+		})
+		if err != nil {
+			return err
+		}
+
+		if flagDiffOutput == outputFormatJSON {
+			return utils.PrintDetail(os.Stdout, "json", diff)
+		}
+		return workflow.RenderDiff(os.Stdout, diff)
+	},
+}
+
+func init() {
+	DiffCmd.Flags().StringVarP(&flagDiffOutput, "output", "o", outputFormatUnified, fmt.Sprintf("Output format. One of %s, %s", outputFormatUnified, outputFormatJSON))
+	WorkflowCmd.AddCommand(DiffCmd)
+}
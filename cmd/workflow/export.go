@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/workflow"
+	"github.com/dapr/kit/signals"
+)
+
+var (
+	exportInstanceID *instanceIDFlag
+	exportOutputFile string
+)
+
+var ExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the metadata and history of a workflow instance to a file.",
+	Long: `Export the metadata and history of a workflow instance to a JSON bundle file.
+The bundle can be rendered offline, without a running Dapr sidecar, using
+'dapr workflow history --from-file'.
+`,
+	Example: `
+dapr workflow export -i 12345678 -a myapp -o bundle.json
+dapr workflow history --from-file bundle.json -o wide
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		if _, err := os.Stat(exportOutputFile); !errors.Is(err, os.ErrNotExist) {
+			if err == nil {
+				return fmt.Errorf("file '%s' already exists", exportOutputFile)
+			}
+			return err
+		}
+
+		appID, err := getWorkflowAppID(cmd)
+		if err != nil {
+			return err
+		}
+
+		bundle, err := workflow.Export(ctx, workflow.ExportOptions{
+			KubernetesMode: flagKubernetesMode,
+			Namespace:      flagDaprNamespace,
+			AppID:          appID,
+			InstanceID:     *exportInstanceID.instanceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(exportOutputFile, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+		if err != nil {
+			return fmt.Errorf("open %s: %w", exportOutputFile, err)
+		}
+		defer f.Close()
+
+		if err := workflow.WriteBundle(f, bundle); err != nil {
+			_ = os.Remove(exportOutputFile)
+			return fmt.Errorf("write %s: %w", exportOutputFile, err)
+		}
+
+		print.SuccessStatusEvent(os.Stdout, "Exported workflow '%s' with %d history events to '%s'", bundle.InstanceID, len(bundle.History), exportOutputFile)
+
+		return nil
+	},
+}
+
+func init() {
+	exportInstanceID = instanceIDCmd(ExportCmd)
+	ExportCmd.MarkFlagRequired("instance-id")
+	ExportCmd.Flags().StringVarP(&exportOutputFile, "output-file", "o", "", "Output file to export the workflow instance to")
+	ExportCmd.MarkFlagRequired("output-file")
+	ExportCmd.MarkFlagFilename("output-file")
+	WorkflowCmd.AddCommand(ExportCmd)
+}
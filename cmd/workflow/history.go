@@ -14,6 +14,7 @@ limitations under the License.
 package workflow
 
 import (
+	"errors"
 	"os"
 
 	"github.com/gocarina/gocsv"
@@ -26,28 +27,75 @@ import (
 
 var (
 	historyOutputFormat *string
+	historyFromFile     string
 )
 
 var HistoryCmd = &cobra.Command{
 	Use:   "history",
 	Short: "Get the history of a workflow instance.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Get the history of a workflow instance.
+The mermaid, dot and html output formats render a timeline of the instance, with
+activities, timers and child workflows drawn from when they were scheduled until
+they finished. The html output is a single self-contained page.
+Use --from-file to render a bundle written by 'dapr workflow export' without a
+running Dapr sidecar.
+`,
+	Example: `
+dapr workflow history 12345678 -a myapp
+dapr workflow history 12345678 -a myapp -o mermaid
+dapr workflow history 12345678 -a myapp -o html > timeline.html
+dapr workflow history --from-file bundle.json
+`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("from-file") {
+			if len(args) > 0 {
+				return errors.New("no arguments are accepted when using --from-file")
+			}
+			return nil
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
-		appID, err := getWorkflowAppID(cmd)
-		if err != nil {
-			return err
+		var opts workflow.HistoryOptions
+		if historyFromFile != "" {
+			opts.FromFile = historyFromFile
+		} else {
+			appID, err := getWorkflowAppID(cmd)
+			if err != nil {
+				return err
+			}
+
+			opts = workflow.HistoryOptions{
+				KubernetesMode: flagKubernetesMode,
+				Namespace:      flagDaprNamespace,
+				AppID:          appID,
+				InstanceID:     args[0],
+			}
 		}
 
-		opts := workflow.HistoryOptions{
-			KubernetesMode: flagKubernetesMode,
-			Namespace:      flagDaprNamespace,
-			AppID:          appID,
-			InstanceID:     args[0],
+		switch *historyOutputFormat {
+		case outputFormatMermaid, outputFormatDOT, outputFormatHTML:
+			timeline, err := workflow.HistoryTimeline(ctx, opts)
+			if err != nil {
+				return err
+			}
+
+			switch *historyOutputFormat {
+			case outputFormatMermaid:
+				return workflow.RenderMermaid(os.Stdout, timeline)
+			case outputFormatDOT:
+				return workflow.RenderDOT(os.Stdout, timeline)
+			default:
+				return workflow.RenderHTML(os.Stdout, timeline)
+			}
 		}
 
-		var list any
+		var (
+			list any
+			err  error
+		)
 		if *historyOutputFormat == outputFormatShort {
 			list, err = workflow.HistoryShort(ctx, opts)
 		} else {
@@ -80,6 +128,8 @@ var HistoryCmd = &cobra.Command{
 }
 
 func init() {
-	historyOutputFormat = outputFunc(HistoryCmd)
+	historyOutputFormat = outputFunc(HistoryCmd, outputFormatMermaid, outputFormatDOT, outputFormatHTML)
+	HistoryCmd.Flags().StringVar(&historyFromFile, "from-file", "", "Render the history from a bundle file written by 'dapr workflow export' instead of a running Dapr sidecar")
+	HistoryCmd.MarkFlagFilename("from-file")
 	WorkflowCmd.AddCommand(HistoryCmd)
 }
@@ -14,6 +14,8 @@ limitations under the License.
 package workflow
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -31,16 +33,48 @@ var (
 	listOutputFormat *string
 
 	listConn *connFlag
+
+	listAllApps       bool
+	listAllNamespaces bool
 )
 
 var ListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List workflows for the given app ID.",
-	Args:    cobra.NoArgs,
+	Long: `List workflows for the given app ID.
+Use --all-apps to list the workflows of every running Dapr app, and -A together
+with -k to include the apps of all Kubernetes namespaces. Apps which cannot be
+listed are reported at the end.
+`,
+	Example: `
+dapr workflow list -a myapp
+dapr workflow list --all-apps
+dapr workflow list -k -A -o wide
+`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if listAllNamespaces && !flagKubernetesMode {
+			return errors.New("--all-namespaces is only supported with --kubernetes")
+		}
+		if listAllNamespaces {
+			listAllApps = true
+		}
+		if listAllApps && cmd.Flags().Changed("app-id") {
+			return errors.New("--app-id cannot be combined with --all-apps")
+		}
+		if listAllApps && *listOutputFormat == outputFormatIDs {
+			return fmt.Errorf("--all-apps cannot be combined with --output=%s", outputFormatIDs)
+		}
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
+		if listAllApps {
+			return listWorkflowsAllApps(ctx)
+		}
+
 		appID, err := getWorkflowAppID(cmd)
 		if err != nil {
 			return err
@@ -126,9 +160,56 @@ var ListCmd = &cobra.Command{
 	},
 }
 
+func listWorkflowsAllApps(ctx context.Context) error {
+	apps, err := getWorkflowApps(listAllNamespaces)
+	if err != nil {
+		return err
+	}
+
+	list, appErrs := workflow.ListWideApps(ctx, apps, workflow.ListOptions{
+		KubernetesMode:   flagKubernetesMode,
+		ConnectionString: listConn.connectionString,
+		TableName:        listConn.tableName,
+		Filter:           *listFilter,
+	})
+
+	if len(list) == 0 {
+		print.FailureStatusEvent(os.Stderr, "No workflow found in %d apps", len(apps))
+	} else {
+		switch *listOutputFormat {
+		case outputFormatYAML:
+			err = utils.PrintDetail(os.Stdout, "yaml", list)
+		case outputFormatJSON:
+			err = utils.PrintDetail(os.Stdout, "json", list)
+		default:
+			// The short table has no app column, so the wide table is always
+			// used when listing across apps.
+			var table string
+			table, err = gocsv.MarshalString(list)
+			if err == nil {
+				utils.PrintTable(table)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, appErr := range appErrs {
+		print.FailureStatusEvent(os.Stderr, "Failed to list workflows of %s", appErr)
+	}
+	if len(appErrs) > 0 {
+		return fmt.Errorf("failed to list workflows of %d out of %d apps", len(appErrs), len(apps))
+	}
+
+	return nil
+}
+
 func init() {
 	listFilter = filterCmd(ListCmd)
 	listOutputFormat = outputFunc(ListCmd, outputFormatIDs)
 	listConn = connectionCmd(ListCmd)
+	ListCmd.Flags().BoolVar(&listAllApps, "all-apps", false, "List the workflows of all running Dapr apps")
+	ListCmd.Flags().BoolVarP(&listAllNamespaces, "all-namespaces", "A", false, "List the workflows of all Dapr apps in all namespaces. Only used with --kubernetes")
 	WorkflowCmd.AddCommand(ListCmd)
 }
@@ -26,16 +26,38 @@ import (
 
 var (
 	flagRaiseEventInput *inputFlag
+	flagRaiseEventName  string
+	flagRaiseEventBulk  *bulkFlag
 )
 
 var RaiseEventCmd = &cobra.Command{
 	Use:   "raise-event",
 	Short: "Raise an event for a workflow waiting for an external event.",
-	Long:  "Raise an event for a workflow waiting for an external event. Expects a single argument '<instance-id>/<event-name>'.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Raise an event for a workflow waiting for an external event. Expects a single argument '<instance-id>/<event-name>'.
+To raise the event for multiple instances, select them with --instance-ids-file
+or filter flags and give the event name with --event-name.
+`,
+	Example: `
+dapr workflow raise-event 12345678/approval -a myapp -x '{"approved": true}'
+dapr workflow raise-event -a myapp --event-name approval --filter-name OrderWorkflow --filter-status RUNNING
+`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
+		if flagRaiseEventBulk.enabled(cmd) {
+			if flagRaiseEventName == "" {
+				return errors.New("--event-name is required when using --instance-ids-file or filter flags")
+			}
+
+			appID, err := getWorkflowAppID(cmd)
+			if err != nil {
+				return err
+			}
+
+			return runBulk(ctx, flagRaiseEventBulk, appID, "signalled with event '"+flagRaiseEventName+"'",
+				workflow.RaiseEventOp(flagRaiseEventName, flagRaiseEventInput.input))
+		}
+
 		split := strings.Split(args[0], "/")
 		if len(split) != 2 {
 			return errors.New("the argument must be in the format '<instance-id>/<event-name>'")
@@ -70,6 +92,9 @@ var RaiseEventCmd = &cobra.Command{
 
 func init() {
 	flagRaiseEventInput = inputCmd(RaiseEventCmd)
+	RaiseEventCmd.Flags().StringVar(&flagRaiseEventName, "event-name", "", "The name of the event to raise when using --instance-ids-file or filter flags")
+	flagRaiseEventBulk = bulkCmd(RaiseEventCmd)
+	RaiseEventCmd.Args = bulkArgs(flagRaiseEventBulk)
 
 	WorkflowCmd.AddCommand(RaiseEventCmd)
 }
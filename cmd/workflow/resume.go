@@ -24,12 +24,21 @@ import (
 
 var (
 	flagResumeReason string
+	flagResumeBulk   *bulkFlag
 )
 
 var ResumeCmd = &cobra.Command{
 	Use:   "resume",
 	Short: "Resume a workflow that is suspended.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Resume a workflow that is suspended.
+Accepts a workflow instance ID argument, or --instance-ids-file or filter flags
+to resume multiple instances.
+`,
+	Example: `
+dapr workflow resume 12345678 -a myapp
+dapr workflow resume -a myapp --filter-status SUSPENDED --dry-run
+dapr workflow resume -a myapp --instance-ids-file ids.txt --yes
+`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
@@ -38,6 +47,10 @@ var ResumeCmd = &cobra.Command{
 			return err
 		}
 
+		if flagResumeBulk.enabled(cmd) {
+			return runBulk(ctx, flagResumeBulk, appID, "resumed", workflow.ResumeOp(flagResumeReason))
+		}
+
 		opts := workflow.ResumeOptions{
 			KubernetesMode: flagKubernetesMode,
 			Namespace:      flagDaprNamespace,
@@ -59,6 +72,8 @@ var ResumeCmd = &cobra.Command{
 
 func init() {
 	ResumeCmd.Flags().StringVarP(&flagResumeReason, "reason", "r", "", "Reason for resuming the workflow")
+	flagResumeBulk = bulkCmd(ResumeCmd)
+	ResumeCmd.Args = bulkArgs(flagResumeBulk)
 
 	WorkflowCmd.AddCommand(ResumeCmd)
 }
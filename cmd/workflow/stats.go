@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gocarina/gocsv"
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/workflow"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/kit/signals"
+)
+
+var (
+	statsFilter       *workflow.Filter
+	statsConn         *connFlag
+	statsOutputFormat string
+	statsTop          int
+	statsSkipHistory  bool
+)
+
+type statsStatusRow struct {
+	Status string `csv:"STATUS"`
+	Count  int    `csv:"COUNT"`
+}
+
+type statsWorkflowRow struct {
+	Name      string `csv:"WORKFLOW"`
+	Total     int    `csv:"TOTAL"`
+	Running   int    `csv:"RUNNING"`
+	Completed int    `csv:"COMPLETED"`
+	Failed    int    `csv:"FAILED"`
+	P50       string `csv:"P50"`
+	P95       string `csv:"P95"`
+	P99       string `csv:"P99"`
+}
+
+type statsFailureRow struct {
+	Count     int    `csv:"COUNT"`
+	Message   string `csv:"FAILURE MESSAGE"`
+	Instances string `csv:"EXAMPLE INSTANCES"`
+}
+
+type statsActivityRow struct {
+	Name      string `csv:"ACTIVITY"`
+	Failures  int    `csv:"FAILURES"`
+	Instances int    `csv:"INSTANCES"`
+	LastError string `csv:"LAST ERROR"`
+}
+
+var StatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show statistics and failure analysis of the workflows of an app.",
+	Long: `Show statistics and failure analysis of the workflows of an app.
+Reports the number of instances per runtime status and workflow name, the
+p50/p95/p99 durations from creation to last update of instances in a terminal
+status, the most frequent failure messages and the activities failing most
+often according to the instance histories.
+
+Failure messages are grouped after replacing numbers, UUIDs and hexadecimal
+IDs with placeholders. Use --skip-history to avoid fetching the history of
+every instance, which leaves out the activity failures. Instances whose
+history cannot be fetched, for example because they were purged meanwhile,
+are skipped and counted.
+
+--top limits the failure groups and failing activities of the short output,
+and the failure groups of the prometheus output, which are labelled by their
+message. The yaml and json outputs report every failure group and failing
+activity.
+`,
+	Example: `
+dapr workflow stats -a myapp
+dapr workflow stats -a myapp --filter-max-age 24h -o json
+dapr workflow stats -a myapp --skip-history -o prometheus > workflows.prom
+`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		outputs := []string{outputFormatShort, outputFormatYAML, outputFormatJSON, outputFormatPrometheus}
+		if !slices.Contains(outputs, statsOutputFormat) {
+			return errors.New("invalid value for --output. Supported values are " + strings.Join(outputs, ", "))
+		}
+		if statsTop < 0 {
+			return errors.New("--top must not be negative")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		appID, err := getWorkflowAppID(cmd)
+		if err != nil {
+			return err
+		}
+
+		stats, err := workflow.CollectStats(ctx, workflow.StatsOptions{
+			KubernetesMode:   flagKubernetesMode,
+			Namespace:        flagDaprNamespace,
+			AppID:            appID,
+			ConnectionString: statsConn.connectionString,
+			TableName:        statsConn.tableName,
+			Filter:           *statsFilter,
+			SkipHistory:      statsSkipHistory,
+		})
+		if err != nil {
+			return err
+		}
+
+		switch statsOutputFormat {
+		case outputFormatYAML:
+			return utils.PrintDetail(os.Stdout, "yaml", stats)
+		case outputFormatJSON:
+			return utils.PrintDetail(os.Stdout, "json", stats)
+		case outputFormatPrometheus:
+			return workflow.WritePrometheus(os.Stdout, stats, statsTop)
+		}
+
+		if stats.Total == 0 {
+			print.FailureStatusEvent(os.Stderr, "No workflow found in namespace %q for app ID %q", flagDaprNamespace, appID)
+			return nil
+		}
+
+		if stats.SkippedHistories > 0 {
+			print.WarningStatusEvent(os.Stderr, "Skipped the activity failures of %d instances whose history could not be fetched", stats.SkippedHistories)
+		}
+
+		if statsTop > 0 {
+			stats.Failures = stats.Failures[:min(statsTop, len(stats.Failures))]
+			stats.ActivityFailures = stats.ActivityFailures[:min(statsTop, len(stats.ActivityFailures))]
+		}
+
+		return printStatsTables(stats)
+	},
+}
+
+func printStatsTables(stats *workflow.Stats) error {
+	statuses := make([]*statsStatusRow, 0, len(stats.Statuses)+1)
+	for _, s := range stats.Statuses {
+		statuses = append(statuses, &statsStatusRow{Status: s.Status, Count: s.Count})
+	}
+	statuses = append(statuses, &statsStatusRow{Status: "TOTAL", Count: stats.Total})
+
+	workflows := make([]*statsWorkflowRow, 0, len(stats.Workflows))
+	for _, w := range stats.Workflows {
+		row := &statsWorkflowRow{
+			Name:  w.Name,
+			Total: w.Total,
+			P50:   statsDuration(w.Durations.P50),
+			P95:   statsDuration(w.Durations.P95),
+			P99:   statsDuration(w.Durations.P99),
+		}
+		for _, s := range w.Statuses {
+			switch s.Status {
+			case "RUNNING":
+				row.Running = s.Count
+			case "COMPLETED":
+				row.Completed = s.Count
+			case "FAILED":
+				row.Failed = s.Count
+			}
+		}
+		workflows = append(workflows, row)
+	}
+
+	tables := []any{statuses, workflows}
+
+	if len(stats.Failures) > 0 {
+		failures := make([]*statsFailureRow, 0, len(stats.Failures))
+		for _, f := range stats.Failures {
+			failures = append(failures, &statsFailureRow{
+				Count:     f.Count,
+				Message:   f.Message,
+				Instances: strings.Join(f.InstanceIDs, ","),
+			})
+		}
+		tables = append(tables, failures)
+	}
+
+	if len(stats.ActivityFailures) > 0 {
+		activities := make([]*statsActivityRow, 0, len(stats.ActivityFailures))
+		for _, a := range stats.ActivityFailures {
+			activities = append(activities, &statsActivityRow{
+				Name:      a.Name,
+				Failures:  a.Failures,
+				Instances: a.Instances,
+				LastError: a.LastError,
+			})
+		}
+		tables = append(tables, activities)
+	}
+
+	for i, rows := range tables {
+		if i > 0 {
+			fmt.Fprintln(os.Stdout)
+		}
+		table, err := gocsv.MarshalString(rows)
+		if err != nil {
+			return err
+		}
+		utils.PrintTable(table)
+	}
+
+	return nil
+}
+
+func statsDuration(seconds float64) string {
+	if seconds == 0 {
+		return "-"
+	}
+	return utils.HumanizeDuration(time.Duration(seconds * float64(time.Second)))
+}
+
+func init() {
+	statsFilter = filterCmd(StatsCmd)
+	statsConn = connectionCmd(StatsCmd)
+	StatsCmd.Flags().StringVarP(&statsOutputFormat, "output", "o", outputFormatShort, "Output format. One of short, yaml, json, prometheus")
+	StatsCmd.Flags().IntVar(&statsTop, "top", 10, "Number of most frequent failure messages and failing activities to show in the short output, and of failure messages in the prometheus output, 0 for all")
+	StatsCmd.Flags().BoolVar(&statsSkipHistory, "skip-history", false, "Do not fetch instance histories, leaving out the activity failures")
+
+	WorkflowCmd.AddCommand(StatsCmd)
+}
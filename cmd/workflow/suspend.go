@@ -24,12 +24,21 @@ import (
 
 var (
 	flagSuspendReason string
+	flagSuspendBulk   *bulkFlag
 )
 
 var SuspendCmd = &cobra.Command{
 	Use:   "suspend",
 	Short: "Suspend a workflow in progress.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Suspend a workflow in progress.
+Accepts a workflow instance ID argument, or --instance-ids-file or filter flags
+to suspend multiple instances.
+`,
+	Example: `
+dapr workflow suspend 12345678 -a myapp
+dapr workflow suspend -a myapp --filter-status RUNNING --dry-run
+dapr workflow suspend -a myapp --instance-ids-file ids.txt --yes
+`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
@@ -38,6 +47,10 @@ var SuspendCmd = &cobra.Command{
 			return err
 		}
 
+		if flagSuspendBulk.enabled(cmd) {
+			return runBulk(ctx, flagSuspendBulk, appID, "suspended", workflow.SuspendOp(flagSuspendReason))
+		}
+
 		opts := workflow.SuspendOptions{
 			KubernetesMode: flagKubernetesMode,
 			Namespace:      flagDaprNamespace,
@@ -58,7 +71,9 @@ var SuspendCmd = &cobra.Command{
 }
 
 func init() {
-	SuspendCmd.Flags().StringVarP(&flagResumeReason, "reason", "r", "", "Reason for resuming the workflow")
+	SuspendCmd.Flags().StringVarP(&flagSuspendReason, "reason", "r", "", "Reason for suspending the workflow")
+	flagSuspendBulk = bulkCmd(SuspendCmd)
+	SuspendCmd.Args = bulkArgs(flagSuspendBulk)
 
 	WorkflowCmd.AddCommand(SuspendCmd)
 }
@@ -24,12 +24,21 @@ import (
 
 var (
 	flagTerminateOutput string
+	flagTerminateBulk   *bulkFlag
 )
 
 var TerminateCmd = &cobra.Command{
 	Use:   "terminate",
 	Short: "Terminate a workflow in progress.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Terminate a workflow in progress.
+Accepts a workflow instance ID argument, or --instance-ids-file or filter flags
+to terminate multiple instances.
+`,
+	Example: `
+dapr workflow terminate 12345678 -a myapp
+dapr workflow terminate -a myapp --filter-name OrderWorkflow --filter-status RUNNING --dry-run
+dapr workflow terminate -a myapp --instance-ids-file ids.txt --yes
+`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := signals.Context()
 
@@ -43,6 +52,10 @@ var TerminateCmd = &cobra.Command{
 			output = &flagTerminateOutput
 		}
 
+		if flagTerminateBulk.enabled(cmd) {
+			return runBulk(ctx, flagTerminateBulk, appID, "terminated", workflow.TerminateOp(output))
+		}
+
 		opts := workflow.TerminateOptions{
 			KubernetesMode: flagKubernetesMode,
 			Namespace:      flagDaprNamespace,
@@ -64,6 +77,8 @@ var TerminateCmd = &cobra.Command{
 
 func init() {
 	TerminateCmd.Flags().StringVarP(&flagTerminateOutput, "output", "o", "", "Optional output data for the workflow in JSON string format.")
+	flagTerminateBulk = bulkCmd(TerminateCmd)
+	TerminateCmd.Args = bulkArgs(flagTerminateBulk)
 
 	WorkflowCmd.AddCommand(TerminateCmd)
 }
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/workflow"
+	"github.com/dapr/kit/signals"
+)
+
+// Exit codes of 'dapr workflow watch'. 124 matches the exit code of the
+// coreutils timeout command.
+const (
+	watchExitCompleted  = 0
+	watchExitError      = 1
+	watchExitFailed     = 2
+	watchExitTerminated = 3
+	watchExitCanceled   = 4
+	watchExitTimeout    = 124
+)
+
+const watchRowFormat = "%-20s  %-22s  %-8s  %-20s  %-10s  %s\n"
+
+var (
+	watchInstanceID   *instanceIDFlag
+	watchUntil        string
+	watchTimeout      time.Duration
+	watchInterval     time.Duration
+	watchOutputFormat string
+)
+
+var WatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch the status and history of a workflow instance.",
+	Long: `Watch the status and history of a workflow instance.
+Prints history events as they are appended to the instance history, and exits
+once the instance reaches a terminal status, or the status given by --until.
+JSON output prints one event per line.
+
+The exit code reflects the final status of the instance:
+  0    COMPLETED, CONTINUED_AS_NEW or the --until status was reached
+  1    the instance could not be watched
+  2    FAILED
+  3    TERMINATED
+  4    CANCELED
+  124  --timeout was reached first
+`,
+	Args: cobra.NoArgs,
+	Example: `
+dapr workflow watch -i 12345678 -a myapp
+dapr workflow watch -i 12345678 -a myapp --until SUSPENDED
+dapr workflow watch -i 12345678 -a myapp --timeout 10m -o json
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		outputs := []string{outputFormatShort, outputFormatJSON}
+		if !slices.Contains(outputs, watchOutputFormat) {
+			return errors.New("invalid value for --output. Supported values are " + strings.Join(outputs, ", "))
+		}
+		if cmd.Flags().Changed("until") && !slices.Contains(workflow.RuntimeStatuses, watchUntil) {
+			return errors.New("invalid value for --until. Supported values are " + strings.Join(workflow.RuntimeStatuses, ", "))
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := signals.Context()
+
+		appID, err := getWorkflowAppID(cmd)
+		if err != nil {
+			return err
+		}
+
+		opts := workflow.WatchOptions{
+			KubernetesMode: flagKubernetesMode,
+			Namespace:      flagDaprNamespace,
+			AppID:          appID,
+			InstanceID:     *watchInstanceID.instanceID,
+			Interval:       watchInterval,
+		}
+		if cmd.Flags().Changed("until") {
+			opts.Until = &watchUntil
+		}
+
+		if watchTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, watchTimeout)
+			defer cancel()
+		}
+
+		var fn func(*workflow.HistoryOutputWide)
+		if watchOutputFormat == outputFormatJSON {
+			enc := json.NewEncoder(os.Stdout)
+			fn = func(row *workflow.HistoryOutputWide) {
+				enc.Encode(row)
+			}
+		} else {
+			fmt.Fprintf(os.Stdout, watchRowFormat, "TIMESTAMP", "TYPE", "EVENTID", "NAME", "STATUS", "DETAILS")
+			fn = func(row *workflow.HistoryOutputWide) {
+				writeWatchRow(os.Stdout, row)
+			}
+		}
+
+		status, err := workflow.Watch(ctx, opts, fn)
+		if err != nil {
+			// gRPC calls interrupted by the context do not always wrap the
+			// context error, so check the context itself.
+			switch {
+			case errors.Is(ctx.Err(), context.DeadlineExceeded):
+				print.FailureStatusEvent(os.Stderr, "Timed out after %s waiting for workflow '%s'", watchTimeout, opts.InstanceID)
+				os.Exit(watchExitTimeout)
+			case ctx.Err() != nil:
+				return nil
+			default:
+				print.FailureStatusEvent(os.Stderr, err.Error())
+				os.Exit(watchExitError)
+			}
+		}
+
+		print.InfoStatusEvent(os.Stderr, "Workflow '%s' reached status %s", opts.InstanceID, status)
+		if opts.Until != nil && status == *opts.Until {
+			return nil
+		}
+
+		if code := watchExitCode(status); code != watchExitCompleted {
+			os.Exit(code)
+		}
+
+		return nil
+	},
+}
+
+func watchExitCode(status string) int {
+	switch status {
+	case "FAILED":
+		return watchExitFailed
+	case "TERMINATED":
+		return watchExitTerminated
+	case "CANCELED":
+		return watchExitCanceled
+	default:
+		return watchExitCompleted
+	}
+}
+
+func writeWatchRow(w io.Writer, row *workflow.HistoryOutputWide) {
+	name, eventID, details := "-", "-", "-"
+	if row.Name != nil {
+		name = *row.Name
+	}
+	if row.EventID != nil {
+		eventID = fmt.Sprintf("%d", *row.EventID)
+	}
+	if row.Details != nil {
+		details = *row.Details
+	}
+	if row.Attrs != nil {
+		details += " " + *row.Attrs
+	}
+
+	fmt.Fprintf(w, watchRowFormat,
+		row.Timestamp.Format(time.RFC3339),
+		row.Type,
+		eventID,
+		name,
+		row.Status,
+		details,
+	)
+}
+
+func init() {
+	watchInstanceID = instanceIDCmd(WatchCmd)
+	WatchCmd.MarkFlagRequired("instance-id")
+	WatchCmd.Flags().StringVar(&watchUntil, "until", "", "Stop watching once the instance reaches the given runtime status. One of "+strings.Join(workflow.RuntimeStatuses, ", "))
+	WatchCmd.Flags().DurationVar(&watchTimeout, "timeout", 0, "Stop watching and exit with code 124 if the instance has not finished within the given duration. Zero waits forever")
+	WatchCmd.Flags().DurationVar(&watchInterval, "interval", time.Second, "Polling interval of the instance status and history")
+	WatchCmd.Flags().StringVarP(&watchOutputFormat, "output", "o", outputFormatShort, fmt.Sprintf("Output format. One of %s, %s", outputFormatShort, outputFormatJSON))
+	WorkflowCmd.AddCommand(WatchCmd)
+}
@@ -35,6 +35,12 @@ const (
 	outputFormatYAML  = "yaml"
 	outputFormatJSON  = "json"
 	outputFormatIDs   = "ids"
+
+	outputFormatMermaid = "mermaid"
+	outputFormatDOT     = "dot"
+	outputFormatHTML    = "html"
+
+	outputFormatPrometheus = "prometheus"
 )
 
 var (
@@ -124,6 +130,50 @@ func getWorkflowAppID(cmd *cobra.Command) (string, error) {
 	return list[0].AppID, nil
 }
 
+// getWorkflowApps returns every Dapr app, deduplicated across replicas. In
+// Kubernetes mode the apps of the given namespace are returned, or those of
+// all namespaces when allNamespaces is set.
+func getWorkflowApps(allNamespaces bool) ([]workflow.App, error) {
+	seen := make(map[workflow.App]bool)
+	var apps []workflow.App
+	add := func(app workflow.App) {
+		if app.AppID == "" || seen[app] {
+			return
+		}
+		seen[app] = true
+		apps = append(apps, app)
+	}
+
+	if flagKubernetesMode {
+		namespace := flagDaprNamespace
+		if allNamespaces {
+			namespace = ""
+		}
+
+		list, err := kubernetes.List(namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range list {
+			add(workflow.App{Namespace: l.Namespace, AppID: l.AppID})
+		}
+	} else {
+		list, err := standalone.List()
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range list {
+			add(workflow.App{Namespace: flagDaprNamespace, AppID: l.AppID})
+		}
+	}
+
+	if len(apps) == 0 {
+		return nil, fmt.Errorf("no Dapr instances found. Please ensure that Dapr is running")
+	}
+
+	return apps, nil
+}
+
 func parseWorkflowDurationTimestamp(str string, durationPast bool) (*time.Time, error) {
 	dur, err := time.ParseDuration(str)
 	if err == nil {
@@ -197,7 +247,7 @@ func connectionCmd(cmd *cobra.Command) *connFlag {
 		flagTableName        string
 	)
 
-	cmd.Flags().StringVarP(&flagConnectionString, "connection-string", "c", "", "Only used for Dapr runtime versions 1.16. The connection string used to connect and authenticate to the actor state store")
+	cmd.Flags().StringVarP(&flagConnectionString, "connection-string", "c", "", "Only used for Dapr runtime versions 1.16. The connection string used to connect and authenticate to the actor state store. By default it is read from the actor state store component and its secrets.")
 	cmd.Flags().StringVarP(&flagTableName, "table-name", "t", "", "The name of the table or collection which is used as the actor state store")
 
 	var cflag connFlag
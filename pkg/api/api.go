@@ -22,9 +22,27 @@ var (
 type Metadata struct {
 	ID                string                      `json:"id"`
 	ActiveActorsCount []MetadataActiveActorsCount `json:"actors"`
+	ActorRuntime      *MetadataActorRuntime       `json:"actorRuntime,omitempty"`
 	Extended          map[string]string           `json:"extended"`
 }
 
+// MetadataActorRuntime contains the actor runtime status reported by newer sidecars.
+type MetadataActorRuntime struct {
+	RuntimeStatus string                      `json:"runtimeStatus"`
+	ActiveActors  []MetadataActiveActorsCount `json:"activeActors"`
+	HostReady     bool                        `json:"hostReady"`
+	Placement     string                      `json:"placement"`
+}
+
+// ActiveActors returns the active actor counts, preferring the actor runtime
+// section over the legacy actors field.
+func (m *Metadata) ActiveActors() []MetadataActiveActorsCount {
+	if m.ActorRuntime != nil && len(m.ActorRuntime.ActiveActors) > 0 {
+		return m.ActorRuntime.ActiveActors
+	}
+	return m.ActiveActorsCount
+}
+
 // MetadataActiveActorsCount contain actorType and count of actors each type has.
 type MetadataActiveActorsCount struct {
 	Type  string `json:"type"`
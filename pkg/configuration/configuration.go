@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+	"github.com/dapr/go-sdk/client"
+)
+
+type Options struct {
+	KubernetesMode bool
+	Namespace      string
+	AppID          string
+	StoreName      string
+	Keys           []string
+	Metadata       map[string]string
+}
+
+type ItemOutput struct {
+	Key      string `csv:"KEY"      json:"key"                yaml:"key"`
+	Value    string `csv:"VALUE"    json:"value"              yaml:"value"`
+	Version  string `csv:"VERSION"  json:"version"            yaml:"version"`
+	Metadata string `csv:"METADATA" json:"-"                  yaml:"-"`
+
+	MetadataMap map[string]string `csv:"-" json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+// Get reads the given keys from a configuration store component through the
+// sidecar of the given app. All keys of the store are returned when no keys
+// are given.
+func Get(ctx context.Context, opts Options) ([]*ItemOutput, error) {
+	cli, err := daprClient(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Cancel()
+
+	items, err := cli.Dapr.GetConfigurationItems(ctx, opts.StoreName, opts.Keys, configurationOpts(opts)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configuration from store %q: %w", opts.StoreName, err)
+	}
+
+	return toOutput(items), nil
+}
+
+// Subscribe subscribes to the given keys of a configuration store component
+// and calls fn with every batch of changed items until the context is
+// cancelled. The subscription is removed from the sidecar before returning.
+func Subscribe(ctx context.Context, opts Options, fn func([]*ItemOutput)) error {
+	cli, err := daprClient(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer cli.Cancel()
+
+	id, err := cli.Dapr.SubscribeConfigurationItems(ctx, opts.StoreName, opts.Keys,
+		func(_ string, items map[string]*client.ConfigurationItem) {
+			if len(items) > 0 {
+				fn(toOutput(items))
+			}
+		},
+		configurationOpts(opts)...,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to configuration store %q: %w", opts.StoreName, err)
+	}
+
+	<-ctx.Done()
+
+	// Use a fresh context as the subscription context is already cancelled.
+	if err := cli.Dapr.UnsubscribeConfigurationItems(context.Background(), opts.StoreName, id); err != nil {
+		return fmt.Errorf("failed to unsubscribe %q from configuration store %q: %w", id, opts.StoreName, err)
+	}
+
+	return nil
+}
+
+func daprClient(ctx context.Context, opts Options) (*dclient.Client, error) {
+	return dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.Namespace,
+		AppID:          opts.AppID,
+		RuntimePath:    runtime.GetDaprRuntimePath(),
+	})
+}
+
+func configurationOpts(opts Options) []client.ConfigurationOpt {
+	copts := make([]client.ConfigurationOpt, 0, len(opts.Metadata))
+	for k, v := range opts.Metadata {
+		copts = append(copts, client.WithConfigurationMetadata(k, v))
+	}
+	return copts
+}
+
+func toOutput(items map[string]*client.ConfigurationItem) []*ItemOutput {
+	out := make([]*ItemOutput, 0, len(items))
+	for key, item := range items {
+		if item == nil {
+			continue
+		}
+
+		out = append(out, &ItemOutput{
+			Key:         key,
+			Value:       item.Value,
+			Version:     item.Version,
+			Metadata:    flatMetadata(item.Metadata),
+			MetadataMap: item.Metadata,
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+
+	return out
+}
+
+func flatMetadata(meta map[string]string) string {
+	if len(meta) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(meta))
+	for k, v := range meta {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+
+	return strings.Join(parts, ",")
+}
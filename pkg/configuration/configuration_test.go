@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/go-sdk/client"
+)
+
+func TestToOutput(t *testing.T) {
+	out := toOutput(map[string]*client.ConfigurationItem{
+		"b": {Value: "2", Version: "v2", Metadata: map[string]string{"z": "1", "a": "2"}},
+		"a": {Value: "1", Version: "v1"},
+		"c": nil,
+	})
+
+	assert.Len(t, out, 2)
+	assert.Equal(t, "a", out[0].Key)
+	assert.Equal(t, "1", out[0].Value)
+	assert.Equal(t, "v1", out[0].Version)
+	assert.Empty(t, out[0].Metadata)
+	assert.Equal(t, "b", out[1].Key)
+	assert.Equal(t, "a=2,z=1", out[1].Metadata)
+	assert.Equal(t, map[string]string{"z": "1", "a": "2"}, out[1].MetadataMap)
+}
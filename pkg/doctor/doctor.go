@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import "fmt"
+
+// Result is the outcome of a check.
+type Result string
+
+const (
+	Pass Result = "pass"
+	Warn Result = "warn"
+	Fail Result = "fail"
+)
+
+// Check is the result of a diagnostic check of a Dapr installation.
+type Check struct {
+	Name    string `csv:"CHECK"   json:"name"    yaml:"name"`
+	Result  Result `csv:"RESULT"  json:"result"  yaml:"result"`
+	Message string `csv:"MESSAGE" json:"message" yaml:"message"`
+}
+
+// Passf returns a passed check.
+func Passf(name, format string, args ...any) Check {
+	return Check{Name: name, Result: Pass, Message: fmt.Sprintf(format, args...)}
+}
+
+// Warnf returns a check with a warning.
+func Warnf(name, format string, args ...any) Check {
+	return Check{Name: name, Result: Warn, Message: fmt.Sprintf(format, args...)}
+}
+
+// Failf returns a failed check.
+func Failf(name, format string, args ...any) Check {
+	return Check{Name: name, Result: Fail, Message: fmt.Sprintf(format, args...)}
+}
+
+// Failed returns whether any of the checks failed.
+func Failed(checks []Check) bool {
+	for _, c := range checks {
+		if c.Result == Fail {
+			return true
+		}
+	}
+	return false
+}
@@ -90,8 +90,22 @@ func GetImageRegistry() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return imageRegistryURI(defaultImageRegistry), nil
+}
+
+// LookupImageRegistry is GetImageRegistry without printing where the images
+// will be pulled from.
+func LookupImageRegistry() (string, error) {
+	defaultImageRegistry, err := utils.LookupDefaultRegistry(githubContainerRegistryName, dockerContainerRegistryName)
+	if err != nil {
+		return "", err
+	}
+	return imageRegistryURI(defaultImageRegistry), nil
+}
+
+func imageRegistryURI(defaultImageRegistry string) string {
 	if defaultImageRegistry == githubContainerRegistryName {
-		return ghcrURI, nil
+		return ghcrURI
 	}
-	return "", nil
+	return ""
 }
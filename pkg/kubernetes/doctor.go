@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	helm "helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/cli/pkg/doctor"
+)
+
+const sidecarInjectorWebhookName = "dapr-sidecar-injector"
+
+// Doctor checks the Dapr control plane of the current Kubernetes context.
+func Doctor() []doctor.Check {
+	client, err := Client()
+	if err != nil {
+		return []doctor.Check{doctor.Failf("Kubernetes", "could not connect to the cluster: %s", err)}
+	}
+
+	sc := &StatusClient{client: client}
+	status, err := sc.Status()
+	if err != nil {
+		return []doctor.Check{doctor.Failf("Control plane", "%s", err)}
+	}
+	checks := checkControlPlane(status)
+	if len(status) == 0 {
+		return checks
+	}
+
+	checks = append(checks, checkCRDs(status[0].Namespace))
+
+	expiry, err := Expiry()
+	if err != nil {
+		checks = append(checks, doctor.Warnf("Certificates", "could not read the root certificate: %s", err))
+	} else {
+		checks = append(checks, checkCertExpiry(*expiry, time.Now().UTC()))
+	}
+
+	return append(checks, checkSidecarInjector(client))
+}
+
+// checkControlPlane checks that all the control plane services are running
+// and healthy.
+func checkControlPlane(status []StatusOutput) []doctor.Check {
+	if len(status) == 0 {
+		return []doctor.Check{doctor.Failf("Control plane", "Dapr is not installed in your cluster. Run `dapr init -k`")}
+	}
+
+	checks := make([]doctor.Check, 0, len(status))
+	for _, s := range status {
+		name := "Service " + s.Name
+		if s.Status != "Running" || s.Healthy != "True" {
+			checks = append(checks, doctor.Failf(name, "%s, healthy: %s. Run `kubectl describe pods -l app=%s -n %s`", s.Status, s.Healthy, s.Name, s.Namespace))
+			continue
+		}
+		checks = append(checks, doctor.Passf(name, "%d replicas running version %s", s.Replicas, s.Version))
+	}
+	return checks
+}
+
+// checkCRDs checks that the CRDs of the Helm release are installed with the
+// versions of its chart.
+func checkCRDs(namespace string) doctor.Check {
+	name := "CRDs"
+	helmConf, err := helmConfig(namespace)
+	if err != nil {
+		return doctor.Warnf(name, "could not read the Helm release: %s", err)
+	}
+	releaseName, err := GetDaprHelmChartName(helmConf)
+	if err != nil {
+		return doctor.Warnf(name, "could not read the Helm release: %s", err)
+	}
+	release, err := helm.NewGet(helmConf).Run(releaseName)
+	if err != nil {
+		return doctor.Warnf(name, "could not read the Helm release %s: %s", releaseName, err)
+	}
+
+	config, err := getConfig()
+	if err != nil {
+		return doctor.Warnf(name, "%s", err)
+	}
+	client, err := apiextensions.NewForConfig(config)
+	if err != nil {
+		return doctor.Warnf(name, "%s", err)
+	}
+	return compareCRDs(release.Chart.CRDObjects(), client)
+}
+
+// compareCRDs checks that the CRDs of a chart are installed and serve the
+// versions of the chart.
+func compareCRDs(chartCRDs []chart.CRD, client apiextensions.Interface) doctor.Check {
+	name := "CRDs"
+	var problems []string
+	for _, c := range chartCRDs {
+		var expected apiextensionsv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(c.File.Data, &expected); err != nil || expected.Name == "" {
+			continue
+		}
+
+		live, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), expected.Name, meta_v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			problems = append(problems, expected.Name+" is not installed")
+			continue
+		} else if err != nil {
+			return doctor.Warnf(name, "could not get CRD %s: %s", expected.Name, err)
+		}
+
+		liveVersions := crdVersions(live)
+		for _, v := range crdVersions(&expected) {
+			if !slices.Contains(liveVersions, v) {
+				problems = append(problems, fmt.Sprintf("%s does not serve %s", expected.Name, v))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return doctor.Failf(name, "%s. Run `dapr upgrade -k` to apply the CRDs of the chart", strings.Join(problems, ", "))
+	}
+	return doctor.Passf(name, "%d CRDs match the chart", len(chartCRDs))
+}
+
+func crdVersions(crd *apiextensionsv1.CustomResourceDefinition) []string {
+	versions := make([]string, 0, len(crd.Spec.Versions))
+	for _, v := range crd.Spec.Versions {
+		if v.Served {
+			versions = append(versions, v.Name)
+		}
+	}
+	return versions
+}
+
+// checkCertExpiry checks the expiry of the root certificate.
+func checkCertExpiry(expiry, now time.Time) doctor.Check {
+	name := "Certificates"
+	daysRemaining := int(expiry.Sub(now).Hours() / 24)
+	switch {
+	case !expiry.After(now):
+		return doctor.Failf(name, "the root certificate expired on %s. Run `dapr mtls renew-certificate -k`", expiry.Format(time.RFC3339))
+	case daysRemaining < warningDaysForCertExpiry:
+		return doctor.Warnf(name, "the root certificate expires in %d days. Run `dapr mtls renew-certificate -k`", daysRemaining)
+	default:
+		return doctor.Passf(name, "the root certificate expires in %d days", daysRemaining)
+	}
+}
+
+// checkSidecarInjector checks that the webhook of the sidecar injector is
+// registered and its service has ready endpoints.
+func checkSidecarInjector(client k8s.Interface) doctor.Check {
+	name := "Sidecar injector webhook"
+	webhookConfig, err := client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(context.TODO(), sidecarInjectorWebhookName, meta_v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return doctor.Failf(name, "the %s webhook is not registered, apps will not get a sidecar", sidecarInjectorWebhookName)
+	} else if err != nil {
+		return doctor.Warnf(name, "could not get the %s webhook: %s", sidecarInjectorWebhookName, err)
+	}
+
+	for _, webhook := range webhookConfig.Webhooks {
+		svc := webhook.ClientConfig.Service
+		if svc == nil {
+			continue
+		}
+
+		endpointSlices, err := client.DiscoveryV1().EndpointSlices(svc.Namespace).List(context.TODO(), meta_v1.ListOptions{
+			LabelSelector: "kubernetes.io/service-name=" + svc.Name,
+		})
+		if err != nil {
+			return doctor.Warnf(name, "could not get the endpoints of service %s/%s: %s", svc.Namespace, svc.Name, err)
+		}
+		ready := 0
+		for _, s := range endpointSlices.Items {
+			for _, e := range s.Endpoints {
+				if e.Conditions.Ready == nil || *e.Conditions.Ready {
+					ready++
+				}
+			}
+		}
+		if ready == 0 {
+			return doctor.Failf(name, "service %s/%s of webhook %s has no ready endpoints, pods cannot be created", svc.Namespace, svc.Name, webhook.Name)
+		}
+	}
+	return doctor.Passf(name, "%s is registered and reachable", sidecarInjectorWebhookName)
+}
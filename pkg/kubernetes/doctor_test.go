@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dapr/cli/pkg/doctor"
+)
+
+func TestCheckControlPlane(t *testing.T) {
+	checks := checkControlPlane(nil)
+	assert.Equal(t, doctor.Fail, checks[0].Result)
+
+	checks = checkControlPlane([]StatusOutput{
+		{Name: "dapr-operator", Namespace: "dapr-system", Healthy: "True", Status: "Running", Replicas: 1, Version: "1.16.0"},
+		{Name: "dapr-sentry", Namespace: "dapr-system", Healthy: "False", Status: "Waiting (CrashLoopBackOff)", Replicas: 1, Version: "1.16.0"},
+	})
+	assert.Equal(t, doctor.Pass, checks[0].Result)
+	assert.Equal(t, doctor.Fail, checks[1].Result)
+	assert.Contains(t, checks[1].Message, "CrashLoopBackOff")
+}
+
+func TestCheckCertExpiry(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, doctor.Pass, checkCertExpiry(now.Add(365*24*time.Hour), now).Result)
+	assert.Equal(t, doctor.Warn, checkCertExpiry(now.Add(10*24*time.Hour), now).Result)
+	assert.Equal(t, doctor.Fail, checkCertExpiry(now.Add(-time.Hour), now).Result)
+}
+
+func TestCompareCRDs(t *testing.T) {
+	chartCRD := chart.CRD{
+		Name: "crds/components.yaml",
+		File: &chart.File{Name: "crds/components.yaml", Data: []byte(`---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: components.dapr.io
+spec:
+  group: dapr.io
+  versions:
+  - name: v1alpha1
+    served: true
+    storage: true
+`)},
+	}
+	liveCRD := func(versions ...string) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "components.dapr.io"}}
+		for _, v := range versions {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: v, Served: true})
+		}
+		return crd
+	}
+
+	check := compareCRDs([]chart.CRD{chartCRD}, apiextensionsfake.NewSimpleClientset(liveCRD("v1alpha1")))
+	assert.Equal(t, doctor.Pass, check.Result)
+
+	check = compareCRDs([]chart.CRD{chartCRD}, apiextensionsfake.NewSimpleClientset(liveCRD("v1beta1")))
+	assert.Equal(t, doctor.Fail, check.Result)
+	assert.Contains(t, check.Message, "components.dapr.io does not serve v1alpha1")
+
+	check = compareCRDs([]chart.CRD{chartCRD}, apiextensionsfake.NewSimpleClientset())
+	assert.Equal(t, doctor.Fail, check.Result)
+	assert.Contains(t, check.Message, "components.dapr.io is not installed")
+}
+
+func TestCheckSidecarInjector(t *testing.T) {
+	webhook := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: sidecarInjectorWebhookName},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name: "sidecar-injector.dapr.io",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{Namespace: "dapr-system", Name: "dapr-sidecar-injector"},
+			},
+		}},
+	}
+	ready := true
+	endpoints := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dapr-sidecar-injector-abc",
+			Namespace: "dapr-system",
+			Labels:    map[string]string{"kubernetes.io/service-name": "dapr-sidecar-injector"},
+		},
+		Endpoints: []discoveryv1.Endpoint{{Conditions: discoveryv1.EndpointConditions{Ready: &ready}}},
+	}
+
+	assert.Equal(t, doctor.Fail, checkSidecarInjector(fake.NewSimpleClientset()).Result)
+	assert.Equal(t, doctor.Fail, checkSidecarInjector(fake.NewSimpleClientset(webhook)).Result)
+	assert.Equal(t, doctor.Pass, checkSidecarInjector(fake.NewSimpleClientset(webhook, endpoints)).Result)
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	helm "helm.sh/helm/v3/pkg/action"
+
+	v1alpha1 "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+)
+
+const (
+	// DriftValues is the source of a drift in the values of the Helm release.
+	DriftValues = "values"
+	// DriftConfiguration is the source of a drift in the daprsystem
+	// configuration.
+	DriftConfiguration = "configuration"
+
+	notSet = "<not set>"
+)
+
+// cliManagedValues are the values `dapr init -k` and `dapr upgrade -k` set
+// from their flags, which are only compared when they are in the values file.
+var cliManagedValues = []string{
+	"global.tag",
+	"global.registry",
+	"global.ha.enabled",
+	"global.mtls.enabled",
+	"dapr_sentry.tls.",
+}
+
+// configurationValues maps the values of the chart to the fields of the
+// daprsystem configuration they are rendered into.
+var configurationValues = map[string]func(*v1alpha1.MTLSSpec) interface{}{
+	"global.mtls.enabled": func(m *v1alpha1.MTLSSpec) interface{} {
+		return m.GetEnabled()
+	},
+	"global.mtls.workloadCertTTL": func(m *v1alpha1.MTLSSpec) interface{} {
+		if m == nil || m.WorkloadCertTTL == nil {
+			return nil
+		}
+		return *m.WorkloadCertTTL
+	},
+	"global.mtls.allowedClockSkew": func(m *v1alpha1.MTLSSpec) interface{} {
+		if m == nil || m.AllowedClockSkew == nil {
+			return nil
+		}
+		return *m.AllowedClockSkew
+	},
+}
+
+// DriftOutput is a difference between a values file and the Dapr control
+// plane.
+type DriftOutput struct {
+	Source   string `csv:"SOURCE"   json:"source"   yaml:"source"`
+	Key      string `csv:"KEY"      json:"key"      yaml:"key"`
+	Expected string `csv:"EXPECTED" json:"expected" yaml:"expected"`
+	Actual   string `csv:"ACTUAL"   json:"actual"   yaml:"actual"`
+}
+
+// Drift compares the values of the Dapr Helm release in a namespace and the
+// daprsystem configuration with values files.
+func Drift(namespace string, valueFiles []string) ([]DriftOutput, error) {
+	desired, err := readValueFiles(valueFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	helmConf, err := helmConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+	releaseName, err := GetDaprHelmChartName(helmConf)
+	if err != nil {
+		return nil, err
+	}
+
+	getValues := helm.NewGetValues(helmConf)
+	userValues, err := getValues.Run(releaseName)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the values of release %s: %w", releaseName, err)
+	}
+	getValues.AllValues = true
+	allValues, err := getValues.Run(releaseName)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the values of release %s: %w", releaseName, err)
+	}
+
+	config, err := GetDaprControlPlaneCurrentConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not get the daprsystem configuration: %w", err)
+	}
+
+	drift := compareValues(desired, userValues, allValues)
+	return append(drift, compareConfiguration(mergeValues(allValues, desired), config)...), nil
+}
+
+// compareValues compares the values of a release with the desired values.
+// The values the user set which are not desired are reported too, unless the
+// CLI manages them.
+func compareValues(desired, userValues, allValues map[string]interface{}) []DriftOutput {
+	desiredFlat := flattenValues(desired)
+	allFlat := flattenValues(allValues)
+	userFlat := flattenValues(userValues)
+
+	var drift []DriftOutput
+	for _, key := range sortedKeys(desiredFlat) {
+		actual, ok := allFlat[key]
+		if ok && equalValues(desiredFlat[key], actual) {
+			continue
+		}
+		drift = append(drift, DriftOutput{Source: DriftValues, Key: key, Expected: formatValue(desiredFlat[key], true), Actual: formatValue(actual, ok)})
+	}
+	for _, key := range sortedKeys(userFlat) {
+		if _, ok := desiredFlat[key]; ok || isCLIManagedValue(key) {
+			continue
+		}
+		drift = append(drift, DriftOutput{Source: DriftValues, Key: key, Expected: notSet, Actual: formatValue(userFlat[key], true)})
+	}
+	return drift
+}
+
+// compareConfiguration compares the daprsystem configuration with the values
+// it is rendered from.
+func compareConfiguration(values map[string]interface{}, config *v1alpha1.Configuration) []DriftOutput {
+	flat := flattenValues(values)
+	var drift []DriftOutput
+	for _, key := range sortedKeys(configurationValues) {
+		expected, ok := flat[key]
+		if !ok {
+			continue
+		}
+		actual := configurationValues[key](config.Spec.MTLSSpec)
+		if actual != nil && equalValues(expected, actual) {
+			continue
+		}
+		drift = append(drift, DriftOutput{Source: DriftConfiguration, Key: key, Expected: formatValue(expected, true), Actual: formatValue(actual, actual != nil)})
+	}
+	return drift
+}
+
+// flattenValues returns the values which are not maps by their path, for
+// example global.ha.enabled.
+func flattenValues(values map[string]interface{}) map[string]interface{} {
+	flat := map[string]interface{}{}
+	var flatten func(prefix string, m map[string]interface{})
+	flatten = func(prefix string, m map[string]interface{}) {
+		for k, v := range m {
+			if child, ok := v.(map[string]interface{}); ok && len(child) > 0 {
+				flatten(prefix+k+".", child)
+				continue
+			}
+			flat[prefix+k] = v
+		}
+	}
+	flatten("", values)
+	return flat
+}
+
+func isCLIManagedValue(key string) bool {
+	for _, managed := range cliManagedValues {
+		if key == managed || (strings.HasSuffix(managed, ".") && strings.HasPrefix(key, managed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalValues compares values through their JSON representation, so numbers
+// of different types are equal.
+func equalValues(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	return formatValue(a, true) == formatValue(b, true)
+}
+
+func formatValue(v interface{}, ok bool) string {
+	if !ok {
+		return notSet
+	}
+	if s, isString := v.(string); isString {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
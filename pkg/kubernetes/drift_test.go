@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1alpha1 "github.com/dapr/dapr/pkg/apis/configuration/v1alpha1"
+	"github.com/dapr/kit/ptr"
+)
+
+func TestCompareValues(t *testing.T) {
+	desired := map[string]interface{}{
+		"global": map[string]interface{}{
+			"ha":        map[string]interface{}{"enabled": true, "replicaCount": float64(3)},
+			"logAsJson": true,
+		},
+		"dapr_operator": map[string]interface{}{"logLevel": "debug"},
+	}
+	userValues := map[string]interface{}{
+		"global": map[string]interface{}{
+			"ha":  map[string]interface{}{"enabled": true},
+			"tag": "1.16.0",
+		},
+		"dapr_placement": map[string]interface{}{"logLevel": "debug"},
+	}
+	allValues := map[string]interface{}{
+		"global": map[string]interface{}{
+			"ha":        map[string]interface{}{"enabled": true, "replicaCount": 3},
+			"logAsJson": false,
+			"tag":       "1.16.0",
+		},
+		"dapr_placement": map[string]interface{}{"logLevel": "debug"},
+	}
+
+	assert.Equal(t, []DriftOutput{
+		{Source: DriftValues, Key: "dapr_operator.logLevel", Expected: "debug", Actual: notSet},
+		{Source: DriftValues, Key: "global.logAsJson", Expected: "true", Actual: "false"},
+		{Source: DriftValues, Key: "dapr_placement.logLevel", Expected: notSet, Actual: "debug"},
+	}, compareValues(desired, userValues, allValues))
+}
+
+func TestCompareConfiguration(t *testing.T) {
+	values := map[string]interface{}{
+		"global": map[string]interface{}{
+			"mtls": map[string]interface{}{"enabled": true, "workloadCertTTL": "24h", "allowedClockSkew": "15m"},
+		},
+	}
+
+	config := GetDefaultConfiguration()
+	assert.Empty(t, compareConfiguration(values, &config))
+
+	config.Spec.MTLSSpec = &v1alpha1.MTLSSpec{Enabled: ptr.Of(false), WorkloadCertTTL: ptr.Of("1h")}
+	assert.Equal(t, []DriftOutput{
+		{Source: DriftConfiguration, Key: "global.mtls.allowedClockSkew", Expected: "15m", Actual: notSet},
+		{Source: DriftConfiguration, Key: "global.mtls.enabled", Expected: "true", Actual: "false"},
+		{Source: DriftConfiguration, Key: "global.mtls.workloadCertTTL", Expected: "24h", Actual: "1h"},
+	}, compareConfiguration(values, &config))
+}
+
+func TestIsCLIManagedValue(t *testing.T) {
+	assert.True(t, isCLIManagedValue("global.tag"))
+	assert.True(t, isCLIManagedValue("dapr_sentry.tls.root.certPEM"))
+	assert.False(t, isCLIManagedValue("global.tagged"))
+	assert.False(t, isCLIManagedValue("global.logAsJson"))
+}
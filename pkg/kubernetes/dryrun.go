@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	helm "helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/cli/utils"
+)
+
+// RenderedRelease is a Helm release which `dapr init -k` would install.
+type RenderedRelease struct {
+	Name      string
+	Namespace string
+	Chart     string
+	Values    map[string]interface{}
+	Manifest  string
+}
+
+// DryRun renders the Helm releases Init would install, without connecting to
+// the cluster.
+func DryRun(config InitConfiguration) ([]RenderedRelease, error) {
+	helmRepoDapr := utils.GetEnv("DAPR_HELM_REPO_URL", daprHelmRepo)
+	version, err := getVersion(daprReleaseName, config.Version, config.ImageRegistryURI)
+	if err != nil {
+		return nil, err
+	}
+	values, err := daprChartValues(config, version)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := renderRelease(daprReleaseName, daprReleaseName, config.Version, helmRepoDapr, config.Namespace, values)
+	if err != nil {
+		return nil, err
+	}
+	releases := []RenderedRelease{rendered}
+
+	if config.EnableDev {
+		values, err = parseChartValues(redisChartValues)
+		if err != nil {
+			return nil, err
+		}
+		rendered, err = renderRelease(redisReleaseName, redisChartName, bitnamiStableVersion, bitnamiHelmRepo, thirdPartyDevNamespace, values)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, rendered)
+
+		rendered, err = renderRelease(zipkinReleaseName, zipkinChartName, latestVersion, zipkinHelmRepo, thirdPartyDevNamespace, map[string]interface{}{})
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, rendered)
+	}
+	return releases, nil
+}
+
+// renderRelease renders the manifests of a chart, including its CRDs, like
+// `helm template` does.
+func renderRelease(releaseName, chartName, releaseVersion, helmRepo, namespace string, values map[string]interface{}) (RenderedRelease, error) {
+	helmConf, err := helmConfig(namespace)
+	if err != nil {
+		return RenderedRelease{}, err
+	}
+
+	helmChart, err := getHelmChart(releaseVersion, chartName, helmRepo, helmConf)
+	if err != nil {
+		return RenderedRelease{}, err
+	}
+
+	installClient := helm.NewInstall(helmConf)
+	installClient.ReleaseName = releaseName
+	installClient.Namespace = namespace
+	installClient.DryRun = true
+	installClient.ClientOnly = true
+	installClient.IncludeCRDs = true
+
+	rel, err := installClient.Run(helmChart, values)
+	if err != nil {
+		return RenderedRelease{}, fmt.Errorf("error rendering the %s chart: %w", chartName, err)
+	}
+
+	return RenderedRelease{
+		Name:      releaseName,
+		Namespace: namespace,
+		Chart:     helmChart.Metadata.Name + "-" + helmChart.Metadata.Version,
+		Values:    values,
+		Manifest:  releaseManifest(rel),
+	}, nil
+}
+
+// releaseManifest returns the manifests of a release followed by the ones of
+// its hooks.
+func releaseManifest(rel *release.Release) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(rel.Manifest))
+	for _, h := range rel.Hooks {
+		fmt.Fprintf(&b, "\n---\n# Source: %s\n%s", h.Path, strings.TrimSpace(h.Manifest))
+	}
+	return b.String()
+}
+
+// WriteReleases writes the manifests of rendered releases, each preceded by
+// its values as comments, so the output is still a stream of manifests.
+func WriteReleases(w io.Writer, releases []RenderedRelease) error {
+	for _, r := range releases {
+		values, err := yaml.Marshal(r.Values)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "# Release %s of chart %s in namespace %s, with the values:\n", r.Name, r.Chart, r.Namespace)
+		for _, line := range strings.Split(strings.TrimSpace(string(values)), "\n") {
+			fmt.Fprintf(w, "#   %s\n", line)
+		}
+		if _, err = fmt.Fprintf(w, "---\n%s\n", r.Manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
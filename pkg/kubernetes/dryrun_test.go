@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestReleaseManifest(t *testing.T) {
+	rel := &release.Release{
+		Manifest: "---\n# Source: dapr/templates/a.yaml\nkind: ConfigMap\n",
+		Hooks: []*release.Hook{
+			{Path: "dapr/templates/hook.yaml", Manifest: "kind: Job\n"},
+		},
+	}
+
+	assert.Equal(t, "---\n# Source: dapr/templates/a.yaml\nkind: ConfigMap\n---\n# Source: dapr/templates/hook.yaml\nkind: Job", releaseManifest(rel))
+}
+
+func TestWriteReleases(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteReleases(&buf, []RenderedRelease{{
+		Name:      "dapr",
+		Namespace: "dapr-system",
+		Chart:     "dapr-1.16.0",
+		Values:    map[string]interface{}{"global": map[string]interface{}{"ha": map[string]interface{}{"enabled": true}}},
+		Manifest:  "kind: ConfigMap",
+	}})
+	require.NoError(t, err)
+
+	assert.Equal(t, `# Release dapr of chart dapr-1.16.0 in namespace dapr-system, with the values:
+#   global:
+#     ha:
+#       enabled: true
+---
+kind: ConfigMap
+`, buf.String())
+}
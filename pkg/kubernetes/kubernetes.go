@@ -56,13 +56,26 @@ const (
 	zipkingConfigurationName = "appconfig"
 )
 
+// redisChartValues are the values of the Redis chart installed in dev mode.
+var redisChartValues = []string{
+	"image.tag=" + redisVersion,
+	"replica.replicaCount=0",
+	"image.repository=bitnamilegacy/redis",
+}
+
 type InitConfiguration struct {
-	Version                   string
-	Namespace                 string
-	EnableMTLS                bool
-	EnableHA                  bool
-	EnableDev                 bool
-	Args                      []string
+	Version    string
+	Namespace  string
+	EnableMTLS bool
+	EnableHA   bool
+	EnableDev  bool
+	// ValueFiles are Helm values files. They override the values of the
+	// flags which are not in ChangedFlags, and are overridden by the others
+	// and by Args.
+	ValueFiles []string
+	Args       []string
+	// ChangedFlags are the names of the flags set on the command line.
+	ChangedFlags              []string
 	Wait                      bool
 	Timeout                   uint
 	ImageRegistryURI          string
@@ -81,13 +94,7 @@ func Init(config InitConfiguration) error {
 	}
 
 	if config.EnableDev {
-		redisChartVals := []string{
-			"image.tag=" + redisVersion,
-			"replica.replicaCount=0",
-			"image.repository=bitnamilegacy/redis",
-		}
-
-		err = installThirdPartyWithConsole(redisReleaseName, redisChartName, bitnamiStableVersion, bitnamiHelmRepo, "Dapr Redis", redisChartVals, config)
+		err = installThirdPartyWithConsole(redisReleaseName, redisChartName, bitnamiStableVersion, bitnamiHelmRepo, "Dapr Redis", redisChartValues, config)
 		if err != nil {
 			return err
 		}
@@ -224,20 +231,19 @@ func getHelmChart(version, releaseName, helmRepo string, config *helm.Configurat
 }
 
 func daprChartValues(config InitConfiguration, version string) (map[string]interface{}, error) {
-	chartVals := map[string]interface{}{}
 	err := utils.ValidateImageVariant(config.ImageVariant)
 	if err != nil {
 		return nil, err
 	}
-	helmVals := []string{
-		fmt.Sprintf("global.ha.enabled=%t", config.EnableHA),
-		fmt.Sprintf("global.mtls.enabled=%t", config.EnableMTLS),
-		"global.tag=" + utils.GetVariantVersion(version, config.ImageVariant),
-	}
+
+	vals := flagValues{changedFlags: config.ChangedFlags}
+	vals.add(fmt.Sprintf("global.ha.enabled=%t", config.EnableHA), "enable-ha")
+	vals.add(fmt.Sprintf("global.mtls.enabled=%t", config.EnableMTLS), "enable-mtls")
+	vals.add("global.tag="+utils.GetVariantVersion(version, config.ImageVariant), "runtime-version", "image-variant")
 	if len(config.ImageRegistryURI) != 0 {
-		helmVals = append(helmVals, "global.registry="+config.ImageRegistryURI)
+		vals.add("global.registry="+config.ImageRegistryURI, "image-registry")
 	}
-	helmVals = append(helmVals, config.Args...)
+	overrides := append(vals.overrides, config.Args...)
 
 	if config.RootCertificateFilePath != "" && config.IssuerCertificateFilePath != "" && config.IssuerPrivateKeyFilePath != "" {
 		rootCertBytes, issuerCertBytes, issuerKeyBytes, err := parseCertificateFiles(
@@ -248,18 +254,13 @@ func daprChartValues(config InitConfiguration, version string) (map[string]inter
 		if err != nil {
 			return nil, err
 		}
-		helmVals = append(helmVals, "dapr_sentry.tls.root.certPEM="+string(rootCertBytes),
+		overrides = append(overrides, "dapr_sentry.tls.root.certPEM="+string(rootCertBytes),
 			"dapr_sentry.tls.issuer.certPEM="+string(issuerCertBytes),
 			"dapr_sentry.tls.issuer.keyPEM="+string(issuerKeyBytes),
 		)
 	}
 
-	for _, v := range helmVals {
-		if err := strvals.ParseInto(v, chartVals); err != nil {
-			return nil, err
-		}
-	}
-	return chartVals, nil
+	return chartValues(vals.defaults, config.ValueFiles, overrides)
 }
 
 func install(releaseName, releaseVersion, helmRepo string, config InitConfiguration) error {
@@ -325,12 +326,9 @@ func installThirdParty(releaseName, chartName, releaseVersion, helmRepo string,
 	installClient.Wait = config.Wait
 	installClient.Timeout = time.Duration(config.Timeout) * time.Second //nolint:gosec
 
-	values := map[string]interface{}{}
-
-	for _, val := range chartVals {
-		if err = strvals.ParseInto(val, values); err != nil {
-			return err
-		}
+	values, err := parseChartValues(chartVals)
+	if err != nil {
+		return err
 	}
 
 	if _, err = installClient.Run(helmChart, values); err != nil {
@@ -340,6 +338,17 @@ func installThirdParty(releaseName, chartName, releaseVersion, helmRepo string,
 	return nil
 }
 
+// parseChartValues parses values in the format of --set.
+func parseChartValues(chartVals []string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for _, val := range chartVals {
+		if err := strvals.ParseInto(val, values); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
 func debugLogf(format string, v ...interface{}) {
 }
 
@@ -27,7 +27,6 @@ import (
 	"helm.sh/helm/v3/pkg/release"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/helm/pkg/strvals"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/hashicorp/go-version"
@@ -61,8 +60,14 @@ var crdsFullResources = []string{
 var versionWithHAScheduler = semver.MustParse("1.15.0-rc.1")
 
 type UpgradeConfig struct {
-	RuntimeVersion   string
-	Args             []string
+	RuntimeVersion string
+	// ValueFiles are Helm values files. They override the values detected
+	// from the installation and those of the flags which are not in
+	// ChangedFlags, and are overridden by the others and by Args.
+	ValueFiles []string
+	Args       []string
+	// ChangedFlags are the names of the flags set on the command line.
+	ChangedFlags     []string
 	Timeout          uint
 	ImageRegistryURI string
 	ImageVariant     string
@@ -337,35 +342,31 @@ func applyCRDs(version string) error {
 }
 
 func upgradeChartValues(ca, issuerCert, issuerKey string, haMode, mtls bool, conf UpgradeConfig) (map[string]interface{}, error) {
-	chartVals := map[string]interface{}{}
-	globalVals := conf.Args
 	err := utils.ValidateImageVariant(conf.ImageVariant)
 	if err != nil {
 		return nil, err
 	}
-	globalVals = append(globalVals, "global.tag="+utils.GetVariantVersion(conf.RuntimeVersion, conf.ImageVariant))
 
+	// The values detected from the installation are defaults, which the
+	// values files override.
+	vals := flagValues{changedFlags: conf.ChangedFlags}
 	if mtls && ca != "" && issuerCert != "" && issuerKey != "" {
-		globalVals = append(globalVals, "dapr_sentry.tls.root.certPEM="+ca,
+		vals.defaults = append(vals.defaults, "dapr_sentry.tls.root.certPEM="+ca,
 			"dapr_sentry.tls.issuer.certPEM="+issuerCert,
 			"dapr_sentry.tls.issuer.keyPEM="+issuerKey,
 		)
 	} else {
-		globalVals = append(globalVals, "global.mtls.enabled=false")
-	}
-	if len(conf.ImageRegistryURI) != 0 {
-		globalVals = append(globalVals, "global.registry="+conf.ImageRegistryURI)
+		vals.defaults = append(vals.defaults, "global.mtls.enabled=false")
 	}
 	if haMode {
-		globalVals = append(globalVals, "global.ha.enabled=true")
+		vals.defaults = append(vals.defaults, "global.ha.enabled=true")
 	}
-
-	for _, v := range globalVals {
-		if err := strvals.ParseInto(v, chartVals); err != nil {
-			return nil, err
-		}
+	vals.add("global.tag="+utils.GetVariantVersion(conf.RuntimeVersion, conf.ImageVariant), "runtime-version", "image-variant")
+	if len(conf.ImageRegistryURI) != 0 {
+		vals.add("global.registry="+conf.ImageRegistryURI, "image-registry")
 	}
-	return chartVals, nil
+
+	return chartValues(vals.defaults, conf.ValueFiles, append(vals.overrides, conf.Args...))
 }
 
 func isDowngrade(targetVersion, existingVersion string) bool {
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"slices"
+
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+	"k8s.io/helm/pkg/strvals"
+)
+
+// chartValues merges the values of a chart in the order Helm applies -f and
+// --set: the defaults, then the values files, then the overrides. The
+// defaults are the values the CLI computes and those of the flags which were
+// not set, while the overrides are those of the flags which were set and of
+// --set.
+func chartValues(defaults, valueFiles, overrides []string) (map[string]interface{}, error) {
+	vals := map[string]interface{}{}
+	for _, v := range defaults {
+		if err := strvals.ParseInto(v, vals); err != nil {
+			return nil, err
+		}
+	}
+
+	fileVals, err := readValueFiles(valueFiles)
+	if err != nil {
+		return nil, err
+	}
+	vals = mergeValues(vals, fileVals)
+
+	for _, v := range overrides {
+		if err := strvals.ParseInto(v, vals); err != nil {
+			return nil, err
+		}
+	}
+	return vals, nil
+}
+
+// flagValues sorts the values derived from flags into the defaults and the
+// overrides of chartValues, depending on whether their flag was set.
+type flagValues struct {
+	changedFlags []string
+	defaults     []string
+	overrides    []string
+}
+
+// add adds a value derived from the given flags, which is an override when
+// any of them was set.
+func (f *flagValues) add(value string, flags ...string) {
+	for _, flag := range flags {
+		if slices.Contains(f.changedFlags, flag) {
+			f.overrides = append(f.overrides, value)
+			return
+		}
+	}
+	f.defaults = append(f.defaults, value)
+}
+
+// readValueFiles reads values files the way `helm install -f` does: each
+// file is merged over the previous ones. A file can also be a URL, or - for
+// stdin.
+func readValueFiles(valueFiles []string) (map[string]interface{}, error) {
+	opts := values.Options{ValueFiles: valueFiles}
+	return opts.MergeValues(getter.All(&cli.EnvSettings{}))
+}
+
+// mergeValues merges the values of b over the values of a, merging the maps
+// they both have.
+func mergeValues(a, b map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		if bv, ok := v.(map[string]interface{}); ok {
+			if av, ok := out[k].(map[string]interface{}); ok {
+				out[k] = mergeValues(av, bv)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
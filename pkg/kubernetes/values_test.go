@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeValuesFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "values.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestReadValueFiles(t *testing.T) {
+	base := writeValuesFile(t, "global:\n  logAsJson: true\n  ha:\n    enabled: true\n    replicaCount: 3\n")
+	override := writeValuesFile(t, "global:\n  ha:\n    replicaCount: 5\n")
+
+	vals, err := readValueFiles([]string{base, override})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"global": map[string]interface{}{
+			"logAsJson": true,
+			"ha":        map[string]interface{}{"enabled": true, "replicaCount": float64(5)},
+		},
+	}, vals)
+
+	_, err = readValueFiles([]string{filepath.Join(t.TempDir(), "missing.yaml")})
+	assert.Error(t, err)
+}
+
+func TestDaprChartValuesWithValueFiles(t *testing.T) {
+	file := writeValuesFile(t, "global:\n  ha:\n    enabled: true\n  logAsJson: true\n  prometheus:\n    enabled: false\n")
+
+	vals, err := daprChartValues(InitConfiguration{
+		EnableMTLS: true,
+		ValueFiles: []string{file},
+		Args:       []string{"global.logAsJson=false"},
+	}, "1.16.0")
+	require.NoError(t, err)
+
+	global := vals["global"].(map[string]interface{})
+	// The file overrides --enable-ha when it is not set, and --set overrides
+	// the file.
+	assert.Equal(t, true, global["ha"].(map[string]interface{})["enabled"])
+	assert.Equal(t, false, global["logAsJson"])
+	assert.Equal(t, map[string]interface{}{"enabled": false}, global["prometheus"])
+	assert.Equal(t, "1.16.0", global["tag"])
+}
+
+func TestUpgradeChartValuesWithValueFiles(t *testing.T) {
+	file := writeValuesFile(t, "global:\n  tag: 1.0.0\n  logAsJson: true\n")
+
+	vals, err := upgradeChartValues("", "", "", false, false, UpgradeConfig{
+		RuntimeVersion: "1.16.0",
+		ValueFiles:     []string{file},
+		Args:           []string{"a=b"},
+		ChangedFlags:   []string{"runtime-version"},
+	})
+	require.NoError(t, err)
+
+	global := vals["global"].(map[string]interface{})
+	assert.Equal(t, "1.16.0", global["tag"])
+	assert.Equal(t, true, global["logAsJson"])
+	assert.Equal(t, "b", vals["a"])
+}
+
+func TestChartValuesPrecedence(t *testing.T) {
+	file := writeValuesFile(t, "global:\n  tag: 1.0.0\n  registry: file.io\n  ha:\n    enabled: true\n  mtls:\n    enabled: false\n")
+	global := func(vals map[string]interface{}) map[string]interface{} {
+		return vals["global"].(map[string]interface{})
+	}
+	enabled := func(vals map[string]interface{}, key string) interface{} {
+		return global(vals)[key].(map[string]interface{})["enabled"]
+	}
+
+	t.Run("init without flags", func(t *testing.T) {
+		vals, err := daprChartValues(InitConfiguration{
+			EnableMTLS:       true,
+			ImageRegistryURI: "default.io",
+			ValueFiles:       []string{file},
+		}, "1.16.0")
+		require.NoError(t, err)
+		assert.Equal(t, true, enabled(vals, "ha"))
+		assert.Equal(t, false, enabled(vals, "mtls"))
+		assert.Equal(t, "1.0.0", global(vals)["tag"])
+		assert.Equal(t, "file.io", global(vals)["registry"])
+	})
+
+	t.Run("init with flags", func(t *testing.T) {
+		vals, err := daprChartValues(InitConfiguration{
+			EnableMTLS:       true,
+			ImageRegistryURI: "flag.io",
+			ValueFiles:       []string{file},
+			Args:             []string{"global.registry=set.io"},
+			ChangedFlags:     []string{"enable-ha", "enable-mtls", "runtime-version", "image-registry"},
+		}, "1.16.0")
+		require.NoError(t, err)
+		assert.Equal(t, false, enabled(vals, "ha"))
+		assert.Equal(t, true, enabled(vals, "mtls"))
+		assert.Equal(t, "1.16.0", global(vals)["tag"])
+		// --set overrides the flags.
+		assert.Equal(t, "set.io", global(vals)["registry"])
+	})
+
+	t.Run("upgrade", func(t *testing.T) {
+		vals, err := upgradeChartValues("", "", "", false, false, UpgradeConfig{
+			RuntimeVersion:   "1.16.0",
+			ImageRegistryURI: "default.io",
+			ValueFiles:       []string{file},
+			ChangedFlags:     []string{"runtime-version"},
+		})
+		require.NoError(t, err)
+		// The values detected from the installation are overridden by the
+		// file, which is overridden by --runtime-version.
+		assert.Equal(t, true, enabled(vals, "ha"))
+		assert.Equal(t, false, enabled(vals, "mtls"))
+		assert.Equal(t, "file.io", global(vals)["registry"])
+		assert.Equal(t, "1.16.0", global(vals)["tag"])
+
+		mtlsFile := writeValuesFile(t, "global:\n  mtls:\n    enabled: true\n")
+		vals, err = upgradeChartValues("", "", "", false, false, UpgradeConfig{
+			RuntimeVersion: "1.16.0",
+			ValueFiles:     []string{mtlsFile},
+			ChangedFlags:   []string{"runtime-version"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, true, enabled(vals, "mtls"))
+	})
+}
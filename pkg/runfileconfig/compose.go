@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runfileconfig
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/dapr/cli/pkg/standalone"
+)
+
+// ExportCompose writes the apps as a Docker Compose file, with a service for
+// each app and a service for its daprd. The runtime version of each app must
+// be set.
+func ExportCompose(apps []App, w io.Writer) error {
+	compose, err := composeFile(apps)
+	if err != nil {
+		return err
+	}
+
+	b, err := yaml.Marshal(compose)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func composeFile(apps []App) (*standalone.ComposeFile, error) {
+	compose := &standalone.ComposeFile{Services: map[string]standalone.ComposeService{}}
+	for _, app := range apps {
+		appService, daprdService, err := standalone.AppComposeServices(app.RunConfig, app.ContainerImage, app.RuntimeVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		daprdName := app.AppID + "-dapr"
+		for _, name := range []string{app.AppID, daprdName} {
+			if _, ok := compose.Services[name]; ok {
+				return nil, fmt.Errorf("duplicate service %q, app IDs must be unique", name)
+			}
+		}
+		compose.Services[app.AppID] = appService
+		compose.Services[daprdName] = daprdService
+	}
+	return compose, nil
+}
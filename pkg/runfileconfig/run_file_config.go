@@ -26,7 +26,6 @@ const (
 	appLogFileNamePrefix   = "app"
 	daprdLogFileNamePrefix = "daprd"
 	logFileExtension       = ".log"
-	logsDir                = "logs"
 	deployDir              = "deploy"
 )
 
@@ -63,7 +62,7 @@ type Common struct {
 }
 
 func (a *App) GetLogsDir() string {
-	logsPath := filepath.Join(a.AppDirPath, standalone.DefaultDaprDirName, logsDir)
+	logsPath := standalone.GetDaprLogsPath(a.AppDirPath)
 	os.MkdirAll(logsPath, 0o755)
 	return logsPath
 }
@@ -75,6 +75,8 @@ func TestRunConfigFile(t *testing.T) {
 		assert.Equal(t, 10, apps[1].AppHealthTimeout)
 		assert.Equal(t, "", apps[0].UnixDomainSocket)
 		assert.Equal(t, "/tmp/test-socket", apps[1].UnixDomainSocket)
+		assert.Equal(t, "1.16.0", apps[0].RuntimeVersion)
+		assert.Equal(t, "1.15.0", apps[1].RuntimeVersion)
 
 		// test resourcesPath and configPath after precedence order logic.
 		assert.Equal(t, filepath.Join(apps[0].AppDirPath, "resources"), apps[0].ResourcesPaths[0])
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+	runtimev1pb "github.com/dapr/dapr/pkg/proto/runtime/v1"
+)
+
+type CreateOptions struct {
+	DaprNamespace  string
+	KubernetesMode bool
+}
+
+// JobDefinition describes an app job to create. Name is the job key in the
+// 'app/{app ID}/{job name}' format.
+type JobDefinition struct {
+	Name      string          `json:"name"`
+	Schedule  *string         `json:"schedule,omitempty"`
+	DueTime   *string         `json:"dueTime,omitempty"`
+	Repeats   *uint32         `json:"repeats,omitempty"`
+	TTL       *string         `json:"ttl,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Overwrite bool            `json:"overwrite,omitempty"`
+}
+
+// ReadJobDefinitions reads a YAML or JSON file containing a list of job
+// definitions.
+func ReadJobDefinitions(path string) ([]JobDefinition, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	var jobs []JobDefinition
+	if err := yaml.UnmarshalStrict(b, &jobs); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("no job definitions found in %s", path)
+	}
+
+	return jobs, nil
+}
+
+// Create schedules the given app jobs through the Jobs API of the sidecar of
+// the app each job targets, so jobs are stored exactly as the app itself
+// would have scheduled them.
+func Create(ctx context.Context, opts CreateOptions, jobs ...JobDefinition) error {
+	byApp := make(map[string][]*runtimev1pb.ScheduleJobRequest)
+	var appIDs []string
+	for _, job := range jobs {
+		appID, req, err := job.toRequest()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := byApp[appID]; !ok {
+			appIDs = append(appIDs, appID)
+		}
+		byApp[appID] = append(byApp[appID], req)
+	}
+
+	for _, appID := range appIDs {
+		if err := createForApp(ctx, opts, appID, byApp[appID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createForApp(ctx context.Context, opts CreateOptions, appID string, reqs []*runtimev1pb.ScheduleJobRequest) error {
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.DaprNamespace,
+		AppID:          appID,
+		RuntimePath:    runtime.GetDaprRuntimePath(),
+	})
+	if err != nil {
+		return err
+	}
+	defer cli.Cancel()
+
+	daprClient := runtimev1pb.NewDaprClient(cli.Dapr.GrpcClientConn())
+	for _, req := range reqs {
+		key := "app/" + appID + "/" + req.GetJob().GetName()
+		if _, err := daprClient.ScheduleJobAlpha1(ctx, req); err != nil {
+			return fmt.Errorf("failed to create job '%s': %w", key, err)
+		}
+
+		print.InfoStatusEvent(os.Stdout, "Created %s in namespace '%s'.", key, opts.DaprNamespace)
+	}
+
+	return nil
+}
+
+func (j JobDefinition) toRequest() (string, *runtimev1pb.ScheduleJobRequest, error) {
+	jobKey, err := parseJobKey(j.Name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if jobKey.appID == nil || jobKey.actorType != nil || jobKey.instanceID != nil || jobKey.activity {
+		return "", nil, fmt.Errorf("only app jobs can be created, expecting job key to be in format 'app/{app ID}/{job name}', got '%s'", j.Name)
+	}
+
+	if j.Schedule == nil && j.DueTime == nil {
+		return "", nil, fmt.Errorf("job '%s' requires at least one of schedule or due time", j.Name)
+	}
+
+	job := &runtimev1pb.Job{
+		Name:     jobKey.name,
+		Schedule: j.Schedule,
+		DueTime:  j.DueTime,
+		Repeats:  j.Repeats,
+		Ttl:      j.TTL,
+	}
+
+	if len(j.Data) > 0 {
+		var val structpb.Value
+		if err := val.UnmarshalJSON(j.Data); err != nil {
+			return "", nil, errors.Join(fmt.Errorf("job '%s' data must be valid JSON", j.Name), err)
+		}
+
+		job.Data, err = anypb.New(&val)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return *jobKey.appID, &runtimev1pb.ScheduleJobRequest{
+		Job:       job,
+		Overwrite: j.Overwrite,
+	}, nil
+}
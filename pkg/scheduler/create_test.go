@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/dapr/kit/ptr"
+)
+
+func TestJobDefinitionToRequest(t *testing.T) {
+	t.Run("app job", func(t *testing.T) {
+		appID, req, err := JobDefinition{
+			Name:      "app/myapp/myjob",
+			Schedule:  ptr.Of("@every 10s"),
+			Repeats:   ptr.Of(uint32(3)),
+			Data:      []byte(`{"key":"value"}`),
+			Overwrite: true,
+		}.toRequest()
+		require.NoError(t, err)
+		assert.Equal(t, "myapp", appID)
+		assert.Equal(t, "myjob", req.GetJob().GetName())
+		assert.Equal(t, "@every 10s", req.GetJob().GetSchedule())
+		assert.Equal(t, uint32(3), req.GetJob().GetRepeats())
+		assert.True(t, req.GetOverwrite())
+
+		var val structpb.Value
+		require.NoError(t, req.GetJob().GetData().UnmarshalTo(&val))
+		assert.Equal(t, "value", val.GetStructValue().GetFields()["key"].GetStringValue())
+	})
+
+	t.Run("actor reminders are rejected", func(t *testing.T) {
+		_, _, err := JobDefinition{
+			Name:     "actor/type/id/name",
+			Schedule: ptr.Of("@every 10s"),
+		}.toRequest()
+		require.ErrorContains(t, err, "only app jobs can be created")
+	})
+
+	t.Run("schedule or due time is required", func(t *testing.T) {
+		_, _, err := JobDefinition{Name: "app/myapp/myjob"}.toRequest()
+		require.ErrorContains(t, err, "requires at least one of schedule or due time")
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, _, err := JobDefinition{
+			Name:    "app/myapp/myjob",
+			DueTime: ptr.Of("1s"),
+			Data:    []byte(`{`),
+		}.toRequest()
+		require.ErrorContains(t, err, "data must be valid JSON")
+	})
+}
+
+func TestReadJobDefinitions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+- name: app/myapp/job1
+  schedule: "@every 10s"
+  data:
+    key: value
+- name: app/other/job2
+  dueTime: 1h
+  repeats: 2
+  overwrite: true
+`), 0o600))
+
+	jobs, err := ReadJobDefinitions(path)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "app/myapp/job1", jobs[0].Name)
+	assert.Equal(t, "@every 10s", *jobs[0].Schedule)
+	assert.JSONEq(t, `{"key":"value"}`, string(jobs[0].Data))
+	assert.Equal(t, "1h", *jobs[1].DueTime)
+	assert.Equal(t, uint32(2), *jobs[1].Repeats)
+	assert.True(t, jobs[1].Overwrite)
+
+	require.NoError(t, os.WriteFile(path, []byte(`- name: app/myapp/job1
+  unknown: field
+`), 0o600))
+	_, err = ReadJobDefinitions(path)
+	require.Error(t, err)
+}
@@ -16,21 +16,51 @@ package scheduler
 import (
 	"context"
 	"encoding/gob"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"sort"
+	"strings"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"sigs.k8s.io/yaml"
 
 	"github.com/dapr/cli/pkg/print"
 	"github.com/dapr/cli/pkg/scheduler/stored"
+	schedulerv1 "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+)
+
+const (
+	ExportFormatGob  = "gob"
+	ExportFormatJSON = "json"
+	ExportFormatYAML = "yaml"
 )
 
 type ExportImportOptions struct {
 	SchedulerNamespace string
 	KubernetesMode     bool
 	TargetFile         string
+
+	// Format is one of gob (default), json or yaml.
+	Format string
+
+	// The following options are only used on import.
+
+	// Filter limits the imported jobs to the given type. All jobs are
+	// imported when empty.
+	Filter string
+	// TargetNamespace, when set, moves all imported jobs into the given
+	// namespace.
+	TargetNamespace *string
+	// DryRun prints what would be imported without writing anything.
+	DryRun bool
+	// SkipExisting leaves jobs which already exist in Scheduler untouched.
+	SkipExisting bool
 }
 
 type ExportFile struct {
@@ -38,6 +68,28 @@ type ExportFile struct {
 	Counters map[string][]byte
 }
 
+// ExportDocument is the human-readable export format. Jobs and counters are
+// rendered with protojson, which also expands the job metadata.
+type ExportDocument struct {
+	Jobs []ExportEntry `json:"jobs"`
+}
+
+type ExportEntry struct {
+	// Name is the job name as shown by 'dapr scheduler list'. It is
+	// informational only and ignored on import.
+	Name    string          `json:"name,omitempty"`
+	Key     string          `json:"key"`
+	Job     json.RawMessage `json:"job,omitempty"`
+	Counter json.RawMessage `json:"counter,omitempty"`
+}
+
+// importEntry is a job and its counter decoded from any export format.
+type importEntry struct {
+	key     string
+	job     *stored.Job
+	counter *stored.Counter
+}
+
 func Export(ctx context.Context, opts ExportImportOptions) error {
 	if _, err := os.Stat(opts.TargetFile); !errors.Is(err, os.ErrNotExist) {
 		if err == nil {
@@ -61,76 +113,173 @@ func Export(ctx context.Context, opts ExportImportOptions) error {
 		return err
 	}
 
+	var encode func(io.Writer) error
+	switch opts.Format {
+	case "", ExportFormatGob:
+		out, err := exportFile(jobs, counters)
+		if err != nil {
+			return err
+		}
+		encode = func(w io.Writer) error {
+			return gob.NewEncoder(w).Encode(out)
+		}
+
+	case ExportFormatJSON, ExportFormatYAML:
+		doc, err := exportDocument(jobs, counters)
+		if err != nil {
+			return err
+		}
+		encode = func(w io.Writer) error {
+			b, err := json.MarshalIndent(doc, "", "  ")
+			if err != nil {
+				return err
+			}
+			if opts.Format == ExportFormatYAML {
+				if b, err = yaml.JSONToYAML(b); err != nil {
+					return err
+				}
+			}
+			_, err = w.Write(b)
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unsupported export format '%s'", opts.Format)
+	}
+
+	f, err := os.OpenFile(opts.TargetFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", opts.TargetFile, err)
+	}
+	defer f.Close()
+
+	if err := encode(f); err != nil {
+		_ = os.Remove(opts.TargetFile)
+		return fmt.Errorf("encode export file: %w", err)
+	}
+
+	print.InfoStatusEvent(os.Stdout, "Exported %d jobs and %d counters.", len(jobs), len(counters))
+	return nil
+}
+
+func exportFile(jobs map[string]*stored.Job, counters map[string]*stored.Counter) (*ExportFile, error) {
 	out := ExportFile{
 		Jobs:     make(map[string][]byte, len(jobs)),
 		Counters: make(map[string][]byte, len(counters)),
 	}
 
-	var b []byte
 	for k, j := range jobs {
-		b, err = proto.Marshal(j)
+		b, err := proto.Marshal(j)
 		if err != nil {
-			return fmt.Errorf("marshal job %q: %w", k, err)
+			return nil, fmt.Errorf("marshal job %q: %w", k, err)
 		}
 		out.Jobs[k] = b
 	}
 	for k, c := range counters {
-		b, err = proto.Marshal(c)
+		b, err := proto.Marshal(c)
 		if err != nil {
-			return fmt.Errorf("marshal counter %q: %w", k, err)
+			return nil, fmt.Errorf("marshal counter %q: %w", k, err)
 		}
 		out.Counters[k] = b
 	}
 
-	f, err := os.OpenFile(opts.TargetFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
-	if err != nil {
-		return fmt.Errorf("open %s: %w", opts.TargetFile, err)
-	}
-	defer f.Close()
+	return &out, nil
+}
 
-	if err := gob.NewEncoder(f).Encode(&out); err != nil {
-		_ = os.Remove(opts.TargetFile)
-		return fmt.Errorf("encode export file: %w", err)
+func exportDocument(jobs map[string]*stored.Job, counters map[string]*stored.Counter) (*ExportDocument, error) {
+	var doc ExportDocument
+	for key, job := range jobs {
+		entry := ExportEntry{Key: key}
+
+		var err error
+		if entry.Job, err = protojson.Marshal(job); err != nil {
+			return nil, fmt.Errorf("marshal job %q: %w", key, err)
+		}
+
+		counter := counters[counterKey(key)]
+		if counter != nil {
+			if entry.Counter, err = protojson.Marshal(counter); err != nil {
+				return nil, fmt.Errorf("marshal counter %q: %w", key, err)
+			}
+		}
+
+		if wide, err := parseJob(&JobCount{Key: key, Job: job, Counter: counter}, Filter{Type: FilterAll}); err == nil && wide != nil {
+			entry.Name = wide.Name
+		}
+
+		doc.Jobs = append(doc.Jobs, entry)
 	}
 
-	print.InfoStatusEvent(os.Stdout, "Exported %d jobs and %d counters.", len(out.Jobs), len(out.Counters))
-	return nil
+	sort.Slice(doc.Jobs, func(i, j int) bool {
+		return doc.Jobs[i].Key < doc.Jobs[j].Key
+	})
+
+	return &doc, nil
 }
 
 func Import(ctx context.Context, opts ExportImportOptions) error {
-	client, cancel, err := EtcdClient(opts.KubernetesMode, opts.SchedulerNamespace)
+	entries, err := readImportFile(opts)
 	if err != nil {
 		return err
 	}
-	defer cancel()
 
-	f, err := os.OpenFile(opts.TargetFile, os.O_RDONLY, 0o600)
+	entries, err = filterImportEntries(entries, opts)
 	if err != nil {
-		return fmt.Errorf("open %s: %w", opts.TargetFile, err)
+		return err
 	}
-	defer f.Close()
 
-	var in ExportFile
-	if err := gob.NewDecoder(f).Decode(&in); err != nil {
-		return fmt.Errorf("decode import file: %w", err)
+	var client *clientv3.Client
+	if opts.SkipExisting || !opts.DryRun {
+		var cancel context.CancelFunc
+		client, cancel, err = EtcdClient(opts.KubernetesMode, opts.SchedulerNamespace)
+		if err != nil {
+			return err
+		}
+		defer cancel()
 	}
 
-	ops := make([]clientv3.Op, 0, len(in.Jobs)+len(in.Counters))
+	if opts.SkipExisting {
+		var existing map[string]*stored.Job
+		existing, err = listJobs(ctx, client)
+		if err != nil {
+			return err
+		}
 
-	for key, b := range in.Jobs {
-		var j stored.Job
-		if err := proto.Unmarshal(b, &j); err != nil {
-			return fmt.Errorf("unmarshal job %q: %w", key, err)
+		kept := entries[:0]
+		for _, e := range entries {
+			if _, ok := existing[e.key]; ok {
+				print.InfoStatusEvent(os.Stdout, "Skipping existing job %s.", e.key)
+				continue
+			}
+			kept = append(kept, e)
 		}
-		ops = append(ops, clientv3.OpPut(key, string(b)))
+		entries = kept
 	}
 
-	for key, b := range in.Counters {
-		var c stored.Counter
-		if err := proto.Unmarshal(b, &c); err != nil {
-			return fmt.Errorf("unmarshal counter %q: %w", key, err)
+	if opts.DryRun {
+		for _, e := range entries {
+			print.InfoStatusEvent(os.Stdout, "Would import %s.", e.key)
+		}
+		print.InfoStatusEvent(os.Stdout, "Dry run: %d jobs would be imported.", len(entries))
+		return nil
+	}
+
+	ops := make([]clientv3.Op, 0, len(entries)*2)
+	for _, e := range entries {
+		if e.job != nil {
+			b, err := proto.Marshal(e.job)
+			if err != nil {
+				return fmt.Errorf("marshal job %q: %w", e.key, err)
+			}
+			ops = append(ops, clientv3.OpPut(e.key, string(b)))
+		}
+		if e.counter != nil {
+			b, err := proto.Marshal(e.counter)
+			if err != nil {
+				return fmt.Errorf("marshal counter %q: %w", e.key, err)
+			}
+			ops = append(ops, clientv3.OpPut(counterKey(e.key), string(b)))
 		}
-		ops = append(ops, clientv3.OpPut(key, string(b)))
 	}
 
 	var end int
@@ -151,3 +300,187 @@ func Import(ctx context.Context, opts ExportImportOptions) error {
 
 	return nil
 }
+
+func readImportFile(opts ExportImportOptions) ([]*importEntry, error) {
+	f, err := os.OpenFile(opts.TargetFile, os.O_RDONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", opts.TargetFile, err)
+	}
+	defer f.Close()
+
+	switch opts.Format {
+	case "", ExportFormatGob:
+		var in ExportFile
+		if err := gob.NewDecoder(f).Decode(&in); err != nil {
+			return nil, fmt.Errorf("decode import file: %w", err)
+		}
+		return entriesFromExportFile(&in)
+
+	case ExportFormatJSON, ExportFormatYAML:
+		b, err := io.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("read import file: %w", err)
+		}
+		if opts.Format == ExportFormatYAML {
+			if b, err = yaml.YAMLToJSON(b); err != nil {
+				return nil, fmt.Errorf("decode import file: %w", err)
+			}
+		}
+
+		var doc ExportDocument
+		if err := json.Unmarshal(b, &doc); err != nil {
+			return nil, fmt.Errorf("decode import file: %w", err)
+		}
+		return entriesFromDocument(&doc)
+
+	default:
+		return nil, fmt.Errorf("unsupported import format '%s'", opts.Format)
+	}
+}
+
+func entriesFromExportFile(in *ExportFile) ([]*importEntry, error) {
+	byKey := make(map[string]*importEntry, len(in.Jobs))
+	for key, b := range in.Jobs {
+		var j stored.Job
+		if err := proto.Unmarshal(b, &j); err != nil {
+			return nil, fmt.Errorf("unmarshal job %q: %w", key, err)
+		}
+		byKey[key] = &importEntry{key: key, job: &j}
+	}
+
+	for key, b := range in.Counters {
+		var c stored.Counter
+		if err := proto.Unmarshal(b, &c); err != nil {
+			return nil, fmt.Errorf("unmarshal counter %q: %w", key, err)
+		}
+
+		jobKey := strings.Replace(key, countersPrefix, jobsPrefix, 1)
+		e, ok := byKey[jobKey]
+		if !ok {
+			e = &importEntry{key: jobKey}
+			byKey[jobKey] = e
+		}
+		e.counter = &c
+	}
+
+	entries := make([]*importEntry, 0, len(byKey))
+	for _, e := range byKey {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	return entries, nil
+}
+
+func entriesFromDocument(doc *ExportDocument) ([]*importEntry, error) {
+	entries := make([]*importEntry, 0, len(doc.Jobs))
+	for _, je := range doc.Jobs {
+		if !strings.HasPrefix(je.Key, jobsPrefix) {
+			return nil, fmt.Errorf("invalid job key %q, expecting prefix %q", je.Key, jobsPrefix)
+		}
+
+		e := &importEntry{key: je.Key}
+		if len(je.Job) > 0 {
+			e.job = new(stored.Job)
+			if err := protojson.Unmarshal(je.Job, e.job); err != nil {
+				return nil, fmt.Errorf("unmarshal job %q: %w", je.Key, err)
+			}
+		}
+		if len(je.Counter) > 0 {
+			e.counter = new(stored.Counter)
+			if err := protojson.Unmarshal(je.Counter, e.counter); err != nil {
+				return nil, fmt.Errorf("unmarshal counter %q: %w", je.Key, err)
+			}
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func filterImportEntries(entries []*importEntry, opts ExportImportOptions) ([]*importEntry, error) {
+	filter := Filter{Type: opts.Filter}
+	if filter.Type == "" {
+		filter.Type = FilterAll
+	}
+
+	kept := make([]*importEntry, 0, len(entries))
+	for _, e := range entries {
+		if filter.Type != FilterAll || opts.TargetNamespace != nil {
+			if e.job == nil {
+				// Counters of unknown jobs can neither be filtered nor moved.
+				continue
+			}
+
+			out, err := parseJob(&JobCount{Key: e.key, Job: e.job}, filter)
+			if err != nil {
+				return nil, fmt.Errorf("decode job %q: %w", e.key, err)
+			}
+			if out == nil {
+				continue
+			}
+		}
+
+		if opts.TargetNamespace != nil {
+			if err := e.moveNamespace(*opts.TargetNamespace); err != nil {
+				return nil, err
+			}
+		}
+
+		kept = append(kept, e)
+	}
+
+	return kept, nil
+}
+
+// moveNamespace rewrites the job key and metadata of the entry to the given
+// namespace. The namespace is part of the key, the job metadata and, for
+// workflow and activity reminders, the internal actor type.
+func (e *importEntry) moveNamespace(namespace string) error {
+	var meta schedulerv1.JobMetadata
+	if err := e.job.GetJob().GetMetadata().UnmarshalTo(&meta); err != nil {
+		return fmt.Errorf("decode job metadata %q: %w", e.key, err)
+	}
+
+	oldNamespace := meta.GetNamespace()
+	if oldNamespace == namespace {
+		return nil
+	}
+
+	// Keys are of the form 'dapr/jobs/{kind}||{namespace}||...'.
+	split := strings.Split(e.key, "||")
+	if len(split) < 3 || split[1] != oldNamespace {
+		return fmt.Errorf("unexpected job key %q for namespace %q", e.key, oldNamespace)
+	}
+	split[1] = namespace
+
+	meta.Namespace = namespace
+	if actor := meta.GetTarget().GetActor(); actor != nil {
+		internalPrefix := "dapr.internal." + oldNamespace + "."
+		if strings.HasPrefix(actor.GetType(), internalPrefix) {
+			newType := "dapr.internal." + namespace + "." + strings.TrimPrefix(actor.GetType(), internalPrefix)
+			for i := 2; i < len(split); i++ {
+				if split[i] == actor.GetType() {
+					split[i] = newType
+				}
+			}
+			actor.Type = newType
+		}
+	}
+
+	metaAny, err := anypb.New(&meta)
+	if err != nil {
+		return err
+	}
+	e.job.Job.Metadata = metaAny
+	e.key = strings.Join(split, "||")
+
+	return nil
+}
+
+func counterKey(jobKey string) string {
+	return strings.Replace(jobKey, jobsPrefix, countersPrefix, 1)
+}
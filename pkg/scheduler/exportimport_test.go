@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	api "github.com/diagridio/go-etcd-cron/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"sigs.k8s.io/yaml"
+
+	"github.com/dapr/cli/pkg/scheduler/stored"
+	schedulerv1 "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	testAppJobKey      = "dapr/jobs/app||default||myapp||myjob"
+	testWorkflowJobKey = "dapr/jobs/actorreminder||default||dapr.internal.default.myapp.workflow||abc||start-0"
+)
+
+func testWorkflowJob(t *testing.T) *stored.Job {
+	t.Helper()
+
+	meta, err := anypb.New(&schedulerv1.JobMetadata{
+		AppId:     "myapp",
+		Namespace: "default",
+		Target: &schedulerv1.JobTargetMetadata{
+			Type: &schedulerv1.JobTargetMetadata_Actor{Actor: &schedulerv1.TargetActorReminder{
+				Type: "dapr.internal.default.myapp.workflow",
+				Id:   "abc",
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	return &stored.Job{Job: &api.Job{DueTime: ptr.Of("0s"), Metadata: meta}}
+}
+
+func testExportJobs(t *testing.T) (map[string]*stored.Job, map[string]*stored.Counter) {
+	t.Helper()
+
+	var appJob stored.Job
+	require.NoError(t, proto.Unmarshal(testAppJob(t, "default", "myapp"), &appJob))
+	var counter stored.Counter
+	require.NoError(t, proto.Unmarshal(testCounter(t, 3, time.Unix(2000, 0)), &counter))
+
+	return map[string]*stored.Job{
+		testAppJobKey:      &appJob,
+		testWorkflowJobKey: testWorkflowJob(t),
+	}, map[string]*stored.Counter{
+		counterKey(testAppJobKey): &counter,
+	}
+}
+
+func TestExportDocumentRoundTrip(t *testing.T) {
+	jobs, counters := testExportJobs(t)
+
+	doc, err := exportDocument(jobs, counters)
+	require.NoError(t, err)
+	require.Len(t, doc.Jobs, 2)
+	assert.Equal(t, testWorkflowJobKey, doc.Jobs[0].Key)
+	assert.Equal(t, "workflow/myapp/abc/start-0", doc.Jobs[0].Name)
+	assert.Empty(t, doc.Jobs[0].Counter)
+	assert.Equal(t, testAppJobKey, doc.Jobs[1].Key)
+	assert.Equal(t, "app/myapp/myjob", doc.Jobs[1].Name)
+
+	// Job metadata is expanded rather than kept as opaque bytes.
+	assert.Contains(t, string(doc.Jobs[1].Job), `"appId":"myapp"`)
+
+	b, err := json.Marshal(doc)
+	require.NoError(t, err)
+	b, err = yaml.JSONToYAML(b)
+	require.NoError(t, err)
+	b, err = yaml.YAMLToJSON(b)
+	require.NoError(t, err)
+
+	var got ExportDocument
+	require.NoError(t, json.Unmarshal(b, &got))
+
+	entries, err := entriesFromDocument(&got)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.True(t, proto.Equal(jobs[testWorkflowJobKey], entries[0].job))
+	assert.Nil(t, entries[0].counter)
+	assert.True(t, proto.Equal(jobs[testAppJobKey], entries[1].job))
+	assert.True(t, proto.Equal(counters[counterKey(testAppJobKey)], entries[1].counter))
+
+	_, err = entriesFromDocument(&ExportDocument{Jobs: []ExportEntry{{Key: "foo"}}})
+	require.Error(t, err)
+}
+
+func TestEntriesFromExportFile(t *testing.T) {
+	jobs, counters := testExportJobs(t)
+	counters["dapr/counters/app||default||myapp||orphan"] = counters[counterKey(testAppJobKey)]
+
+	file, err := exportFile(jobs, counters)
+	require.NoError(t, err)
+
+	entries, err := entriesFromExportFile(file)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, testWorkflowJobKey, entries[0].key)
+
+	assert.Equal(t, testAppJobKey, entries[1].key)
+	assert.NotNil(t, entries[1].job)
+	assert.NotNil(t, entries[1].counter)
+
+	assert.Equal(t, "dapr/jobs/app||default||myapp||orphan", entries[2].key)
+	assert.Nil(t, entries[2].job)
+	assert.NotNil(t, entries[2].counter)
+}
+
+func TestFilterImportEntries(t *testing.T) {
+	entries := func(t *testing.T) []*importEntry {
+		jobs, counters := testExportJobs(t)
+		file, err := exportFile(jobs, counters)
+		require.NoError(t, err)
+		e, err := entriesFromExportFile(file)
+		require.NoError(t, err)
+		return e
+	}
+
+	t.Run("no filter keeps all", func(t *testing.T) {
+		got, err := filterImportEntries(entries(t), ExportImportOptions{})
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+	})
+
+	t.Run("filter by type", func(t *testing.T) {
+		got, err := filterImportEntries(entries(t), ExportImportOptions{Filter: FilterWorkflow})
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, testWorkflowJobKey, got[0].key)
+	})
+
+	t.Run("namespace rewrite", func(t *testing.T) {
+		got, err := filterImportEntries(entries(t), ExportImportOptions{
+			Filter:          FilterAll,
+			TargetNamespace: ptr.Of("staging"),
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		assert.Equal(t, "dapr/jobs/app||staging||myapp||myjob", got[1].key)
+		assert.Equal(t, "dapr/counters/app||staging||myapp||myjob", counterKey(got[1].key))
+		assert.NotNil(t, got[1].counter)
+
+		assert.Equal(t, "dapr/jobs/actorreminder||staging||dapr.internal.staging.myapp.workflow||abc||start-0", got[0].key)
+
+		var meta schedulerv1.JobMetadata
+		require.NoError(t, got[0].job.GetJob().GetMetadata().UnmarshalTo(&meta))
+		assert.Equal(t, "staging", meta.GetNamespace())
+		assert.Equal(t, "dapr.internal.staging.myapp.workflow", meta.GetTarget().GetActor().GetType())
+
+		out, err := parseJob(&JobCount{Key: got[0].key, Job: got[0].job}, Filter{Type: FilterWorkflow})
+		require.NoError(t, err)
+		require.NotNil(t, out)
+		assert.Equal(t, "staging", out.Namespace)
+	})
+}
@@ -192,6 +192,28 @@ func parseJobKey(key string) (*jobKey, error) {
 	}
 }
 
+// ActorReminderKey returns the job key of an actor reminder, in the
+// 'actor/{actor type}/{actor id}/{name}' format accepted by the scheduler
+// get and delete commands.
+func ActorReminderKey(actorType, actorID, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", FilterActor, actorType, actorID, name)
+}
+
+// ParseActorReminderKey parses an 'actor/{actor type}/{actor id}/{name}' job
+// key into its actor type, actor ID and reminder name.
+func ParseActorReminderKey(key string) (string, string, string, error) {
+	jobKey, err := parseJobKey(key)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if jobKey.actorType == nil {
+		return "", "", "", fmt.Errorf("expecting actor reminder key to be in format 'actor/{actor type}/{actor id}/{name}', got '%s'", key)
+	}
+
+	return *jobKey.actorType, *jobKey.actorID, jobKey.name, nil
+}
+
 func EtcdClient(kubernetesMode bool, schedulerNamespace string) (*clientv3.Client, context.CancelFunc, error) {
 	var etcdClient *clientv3.Client
 	var err error
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/cli/pkg/scheduler/stored"
+)
+
+const (
+	jobsPrefix     = "dapr/jobs/"
+	countersPrefix = "dapr/counters/"
+)
+
+const (
+	WatchEventCreate  = "CREATE"
+	WatchEventUpdate  = "UPDATE"
+	WatchEventDelete  = "DELETE"
+	WatchEventTrigger = "TRIGGER"
+)
+
+type WatchOptions struct {
+	SchedulerNamespace string
+	KubernetesMode     bool
+	Filter             Filter
+}
+
+type WatchEvent struct {
+	Time     time.Time       `json:"time"`
+	Type     string          `json:"type"`
+	Revision int64           `json:"revision"`
+	Job      *ListOutputWide `json:"job"`
+}
+
+// watcher tracks the jobs and counters seen so far, so that counter updates
+// and job deletions can be decoded with the job they belong to.
+type watcher struct {
+	filter   Filter
+	jobs     map[string]*stored.Job
+	counters map[string]*stored.Counter
+}
+
+// Watch streams job creations, updates and deletions, and counter increments
+// of triggered jobs, to fn until the context is cancelled or fn returns an
+// error.
+func Watch(ctx context.Context, opts WatchOptions, fn func(*WatchEvent) error) error {
+	client, cancel, err := EtcdClient(opts.KubernetesMode, opts.SchedulerNamespace)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	w := &watcher{
+		filter:   opts.Filter,
+		jobs:     make(map[string]*stored.Job),
+		counters: make(map[string]*stored.Counter),
+	}
+
+	// Take a snapshot first so the watch starts from a consistent revision
+	// and already-existing jobs can be resolved when their counters change.
+	resp, err := client.Get(ctx, "dapr/", clientv3.WithPrefix(), clientv3.WithLimit(0))
+	if err != nil {
+		return err
+	}
+	for _, kv := range resp.Kvs {
+		if err = w.store(string(kv.Key), kv.Value); err != nil {
+			return err
+		}
+	}
+
+	wch := client.Watch(ctx, "dapr/",
+		clientv3.WithPrefix(),
+		clientv3.WithPrevKV(),
+		clientv3.WithRev(resp.Header.Revision+1),
+	)
+
+	for wresp := range wch {
+		if err = wresp.Err(); err != nil {
+			return err
+		}
+
+		for _, ev := range wresp.Events {
+			event, err := w.handle(ev)
+			if err != nil {
+				return err
+			}
+
+			if event == nil {
+				continue
+			}
+
+			event.Revision = ev.Kv.ModRevision
+			if err = fn(event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ctx.Err()
+}
+
+func (w *watcher) store(key string, value []byte) error {
+	switch {
+	case strings.HasPrefix(key, jobsPrefix):
+		var job stored.Job
+		if err := proto.Unmarshal(value, &job); err != nil {
+			return fmt.Errorf("failed to unmarshal job %s: %w", key, err)
+		}
+		w.jobs[key] = &job
+	case strings.HasPrefix(key, countersPrefix):
+		var counter stored.Counter
+		if err := proto.Unmarshal(value, &counter); err != nil {
+			return fmt.Errorf("failed to unmarshal counter %s: %w", key, err)
+		}
+		w.counters[key] = &counter
+	}
+
+	return nil
+}
+
+func (w *watcher) handle(ev *clientv3.Event) (*WatchEvent, error) {
+	key := string(ev.Kv.Key)
+
+	var (
+		jobKey    string
+		eventType string
+	)
+
+	switch {
+	case strings.HasPrefix(key, jobsPrefix):
+		jobKey = key
+		switch {
+		case ev.Type == clientv3.EventTypeDelete:
+			eventType = WatchEventDelete
+			if ev.PrevKv != nil {
+				if err := w.store(key, ev.PrevKv.Value); err != nil {
+					return nil, err
+				}
+			}
+		case ev.IsCreate():
+			eventType = WatchEventCreate
+		default:
+			eventType = WatchEventUpdate
+		}
+
+	case strings.HasPrefix(key, countersPrefix):
+		jobKey = strings.Replace(key, countersPrefix, jobsPrefix, 1)
+		if ev.Type == clientv3.EventTypeDelete {
+			// Counters are deleted alongside their job, which is reported by
+			// the job event itself.
+			delete(w.counters, key)
+			return nil, nil
+		}
+		eventType = WatchEventTrigger
+
+	default:
+		return nil, nil
+	}
+
+	if ev.Type != clientv3.EventTypeDelete {
+		if err := w.store(key, ev.Kv.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	job, ok := w.jobs[jobKey]
+	if !ok {
+		return nil, nil
+	}
+
+	out, err := parseJob(&JobCount{
+		Key:     jobKey,
+		Job:     job,
+		Counter: w.counters[strings.Replace(jobKey, jobsPrefix, countersPrefix, 1)],
+	}, w.filter)
+
+	if eventType == WatchEventDelete {
+		delete(w.jobs, jobKey)
+	}
+
+	if err != nil || out == nil {
+		return nil, err
+	}
+
+	return &WatchEvent{
+		Time: time.Now(),
+		Type: eventType,
+		Job:  out,
+	}, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/diagridio/go-etcd-cron/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/cli/pkg/scheduler/stored"
+	schedulerv1 "github.com/dapr/dapr/pkg/proto/scheduler/v1"
+	"github.com/dapr/kit/ptr"
+)
+
+func testAppJob(t *testing.T, namespace, appID string) []byte {
+	t.Helper()
+
+	meta, err := anypb.New(&schedulerv1.JobMetadata{
+		AppId:     appID,
+		Namespace: namespace,
+		Target: &schedulerv1.JobTargetMetadata{
+			Type: &schedulerv1.JobTargetMetadata_Job{Job: new(schedulerv1.TargetJob)},
+		},
+	})
+	require.NoError(t, err)
+
+	b, err := proto.Marshal(&stored.Job{
+		Begin: &stored.Job_Start{Start: timestamppb.New(time.Unix(1000, 0))},
+		Job: &api.Job{
+			Schedule: ptr.Of("@every 10s"),
+			Metadata: meta,
+		},
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func testCounter(t *testing.T, count uint32, last time.Time) []byte {
+	t.Helper()
+
+	b, err := proto.Marshal(&stored.Counter{
+		Count:       count,
+		LastTrigger: timestamppb.New(last),
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func TestWatcherHandle(t *testing.T) {
+	const (
+		jobKey     = "dapr/jobs/app||default||myapp||myjob"
+		counterKey = "dapr/counters/app||default||myapp||myjob"
+	)
+
+	w := &watcher{
+		filter:   Filter{Type: FilterAll, Namespace: ptr.Of("default")},
+		jobs:     make(map[string]*stored.Job),
+		counters: make(map[string]*stored.Counter),
+	}
+
+	job := testAppJob(t, "default", "myapp")
+
+	ev, err := w.handle(&clientv3.Event{
+		Type: clientv3.EventTypePut,
+		Kv:   &mvccpb.KeyValue{Key: []byte(jobKey), Value: job, CreateRevision: 5, ModRevision: 5},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, ev)
+	assert.Equal(t, WatchEventCreate, ev.Type)
+	assert.Equal(t, "app/myapp/myjob", ev.Job.Name)
+
+	ev, err = w.handle(&clientv3.Event{
+		Type: clientv3.EventTypePut,
+		Kv:   &mvccpb.KeyValue{Key: []byte(jobKey), Value: job, CreateRevision: 5, ModRevision: 6},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, WatchEventUpdate, ev.Type)
+
+	last := time.Unix(2000, 0)
+	ev, err = w.handle(&clientv3.Event{
+		Type: clientv3.EventTypePut,
+		Kv:   &mvccpb.KeyValue{Key: []byte(counterKey), Value: testCounter(t, 3, last), ModRevision: 7},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, ev)
+	assert.Equal(t, WatchEventTrigger, ev.Type)
+	assert.Equal(t, uint32(3), ev.Job.Count)
+	assert.Equal(t, last.UTC(), ev.Job.LastTrigger.UTC())
+
+	ev, err = w.handle(&clientv3.Event{
+		Type:   clientv3.EventTypeDelete,
+		Kv:     &mvccpb.KeyValue{Key: []byte(jobKey), ModRevision: 8},
+		PrevKv: &mvccpb.KeyValue{Key: []byte(jobKey), Value: job},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, WatchEventDelete, ev.Type)
+	assert.Empty(t, w.jobs)
+
+	ev, err = w.handle(&clientv3.Event{
+		Type: clientv3.EventTypeDelete,
+		Kv:   &mvccpb.KeyValue{Key: []byte(counterKey), ModRevision: 8},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, ev)
+
+	t.Run("filtered out by namespace", func(t *testing.T) {
+		ev, err := w.handle(&clientv3.Event{
+			Type: clientv3.EventTypePut,
+			Kv: &mvccpb.KeyValue{
+				Key:            []byte("dapr/jobs/app||other||myapp||myjob"),
+				Value:          testAppJob(t, "other", "myapp"),
+				CreateRevision: 9, ModRevision: 9,
+			},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, ev)
+	})
+
+	t.Run("unrelated keys are ignored", func(t *testing.T) {
+		ev, err := w.handle(&clientv3.Event{
+			Type: clientv3.EventTypePut,
+			Kv:   &mvccpb.KeyValue{Key: []byte("dapr/leadership/0"), Value: []byte("x")},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, ev)
+	})
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dapr/cli/pkg/api"
+	"github.com/dapr/cli/pkg/metadata"
+)
+
+// actorRequestTimeout bounds the requests to the actor API of the sidecar,
+// including the actor method calls it forwards.
+const actorRequestTimeout = time.Minute
+
+// ActorReminder is the body of the actor reminders API of the sidecar.
+type ActorReminder struct {
+	DueTime string          `json:"dueTime,omitempty" yaml:"dueTime,omitempty"`
+	Period  string          `json:"period,omitempty"  yaml:"period,omitempty"`
+	TTL     string          `json:"ttl,omitempty"     yaml:"ttl,omitempty"`
+	Data    json.RawMessage `json:"data,omitempty"    yaml:"data,omitempty"`
+}
+
+// ActorTypes returns the actor types and active actor counts hosted by an app ID.
+func (s *Standalone) ActorTypes(appID, socket string) ([]api.MetadataActiveActorsCount, error) {
+	instance, err := s.actorInstance(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := metadata.Get(instance.HTTPPort, appID, socket)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.ActiveActors(), nil
+}
+
+// InvokeActor invokes a method on an actor through the sidecar of an app ID.
+func (s *Standalone) InvokeActor(appID, actorType, actorID, method string, data []byte, verb, socket string) (string, error) {
+	r, err := s.actorRequest(appID, socket, verb, actorPath(actorType, actorID, "method", method), data)
+	if err != nil {
+		return "", err
+	}
+	defer r.Body.Close()
+
+	return handleResponse(r)
+}
+
+// GetActorState reads a key of an actor's state through the sidecar of an app ID.
+func (s *Standalone) GetActorState(appID, actorType, actorID, key, socket string) (string, error) {
+	r, err := s.actorRequest(appID, socket, http.MethodGet, actorPath(actorType, actorID, "state", key), nil)
+	if err != nil {
+		return "", err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode == http.StatusNoContent {
+		return "", fmt.Errorf("key %q not found in state of actor %s/%s", key, actorType, actorID)
+	}
+
+	return handleResponse(r)
+}
+
+// CreateActorReminder creates or updates an actor reminder through the sidecar of an app ID.
+func (s *Standalone) CreateActorReminder(appID, actorType, actorID, name string, reminder ActorReminder, socket string) error {
+	return s.actorPut(appID, socket, actorPath(actorType, actorID, "reminders", name), reminder)
+}
+
+// GetActorReminder gets an actor reminder through the sidecar of an app ID.
+func (s *Standalone) GetActorReminder(appID, actorType, actorID, name, socket string) (*ActorReminder, error) {
+	r, err := s.actorRequest(appID, socket, http.MethodGet, actorPath(actorType, actorID, "reminders", name), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	body, err := handleResponse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if body == "" {
+		return nil, fmt.Errorf("reminder %q not found for actor %s/%s", name, actorType, actorID)
+	}
+
+	var reminder ActorReminder
+	if err := json.Unmarshal([]byte(body), &reminder); err != nil {
+		return nil, fmt.Errorf("failed to decode reminder %q: %w", name, err)
+	}
+
+	return &reminder, nil
+}
+
+// DeleteActorReminder deletes an actor reminder through the sidecar of an app ID.
+func (s *Standalone) DeleteActorReminder(appID, actorType, actorID, name, socket string) error {
+	return s.actorDelete(appID, socket, actorPath(actorType, actorID, "reminders", name))
+}
+
+func (s *Standalone) actorPut(appID, socket, path string, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	r, err := s.actorRequest(appID, socket, http.MethodPut, path, b)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	_, err = handleResponse(r)
+	return err
+}
+
+func (s *Standalone) actorDelete(appID, socket, path string) error {
+	r, err := s.actorRequest(appID, socket, http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	_, err = handleResponse(r)
+	return err
+}
+
+func (s *Standalone) actorInstance(appID string) (ListOutput, error) {
+	list, err := s.process.List()
+	if err != nil {
+		return ListOutput{}, err
+	}
+
+	for _, lo := range list {
+		if lo.AppID == appID {
+			return lo, nil
+		}
+	}
+
+	return ListOutput{}, fmt.Errorf("app ID %s not found", appID)
+}
+
+func (s *Standalone) actorRequest(appID, socket, verb, path string, data []byte) (*http.Response, error) {
+	instance, err := s.actorInstance(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewBuffer(data)
+	}
+
+	endpoint := fmt.Sprintf("http://127.0.0.1:%d/v%s/actors/%s", instance.HTTPPort, api.RuntimeAPIVersion, path)
+	req, err := http.NewRequest(verb, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpc := http.Client{
+		Transport: socketTransport(socket, appID),
+		Timeout:   actorRequestTimeout,
+	}
+
+	return httpc.Do(req)
+}
+
+func actorPath(actorType, actorID, kind, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s",
+		url.PathEscape(actorType), url.PathEscape(actorID), kind, url.PathEscape(name),
+	)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActor(t *testing.T) {
+	type request struct {
+		method string
+		path   string
+		body   string
+	}
+
+	var got request
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		got = request{method: r.Method, path: r.URL.EscapedPath(), body: string(b)}
+
+		switch r.URL.Path {
+		case "/v1.0/metadata":
+			w.Write([]byte(`{"id":"testapp","actorRuntime":{"activeActors":[{"type":"Account","count":2}]}}`))
+		case "/v1.0/actors/Account/acc-1/state/missing":
+			w.WriteHeader(http.StatusNoContent)
+		case "/v1.0/actors/Account/acc-1/state/balance":
+			w.Write([]byte(`42`))
+		case "/v1.0/actors/Account/acc-1/reminders/interest":
+			if r.Method == http.MethodGet {
+				w.Write([]byte(`{"dueTime":"5s","period":"10s","data":{"rate":2}}`))
+			}
+		case "/v1.0/actors/Account/acc-1/method/deposit":
+			w.Write(b)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ts, port := getTestServerFunc(handler)
+	ts.Start()
+	defer ts.Close()
+
+	client := &Standalone{
+		process: &mockDaprProcess{
+			Lo: []ListOutput{{AppID: "testapp", HTTPPort: port}},
+		},
+	}
+
+	t.Run("unknown app ID", func(t *testing.T) {
+		_, err := client.InvokeActor("invalid", "Account", "acc-1", "deposit", nil, http.MethodPost, "")
+		require.EqualError(t, err, "app ID invalid not found")
+	})
+
+	t.Run("actor types from metadata", func(t *testing.T) {
+		types, err := client.ActorTypes("testapp", "")
+		require.NoError(t, err)
+		require.Len(t, types, 1)
+		assert.Equal(t, "Account", types[0].Type)
+		assert.Equal(t, 2, types[0].Count)
+	})
+
+	t.Run("invoke", func(t *testing.T) {
+		res, err := client.InvokeActor("testapp", "Account", "acc-1", "deposit", []byte(`{"amount":10}`), http.MethodPost, "")
+		require.NoError(t, err)
+		assert.Equal(t, `{"amount":10}`, res)
+		assert.Equal(t, http.MethodPost, got.method)
+	})
+
+	t.Run("get state", func(t *testing.T) {
+		res, err := client.GetActorState("testapp", "Account", "acc-1", "balance", "")
+		require.NoError(t, err)
+		assert.Equal(t, "42", res)
+	})
+
+	t.Run("get missing state", func(t *testing.T) {
+		_, err := client.GetActorState("testapp", "Account", "acc-1", "missing", "")
+		require.EqualError(t, err, `key "missing" not found in state of actor Account/acc-1`)
+	})
+
+	t.Run("create reminder", func(t *testing.T) {
+		err := client.CreateActorReminder("testapp", "Account", "acc-1", "interest", ActorReminder{
+			DueTime: "5s",
+			Period:  "10s",
+			Data:    json.RawMessage(`{"rate":2}`),
+		}, "")
+		require.NoError(t, err)
+		assert.Equal(t, http.MethodPut, got.method)
+		assert.Equal(t, "/v1.0/actors/Account/acc-1/reminders/interest", got.path)
+		assert.JSONEq(t, `{"dueTime":"5s","period":"10s","data":{"rate":2}}`, got.body)
+	})
+
+	t.Run("get reminder", func(t *testing.T) {
+		reminder, err := client.GetActorReminder("testapp", "Account", "acc-1", "interest", "")
+		require.NoError(t, err)
+		assert.Equal(t, "5s", reminder.DueTime)
+		assert.Equal(t, "10s", reminder.Period)
+		assert.JSONEq(t, `{"rate":2}`, string(reminder.Data))
+	})
+
+	t.Run("delete reminder", func(t *testing.T) {
+		require.NoError(t, client.DeleteActorReminder("testapp", "Account", "acc-1", "interest", ""))
+		assert.Equal(t, http.MethodDelete, got.method)
+	})
+
+	t.Run("delete unknown reminder", func(t *testing.T) {
+		err := client.DeleteActorReminder("testapp", "Account", "acc-1", "nope", "")
+		require.Error(t, err)
+	})
+
+	t.Run("escapes path segments", func(t *testing.T) {
+		client.DeleteActorReminder("testapp", "Account", "acc/1", "r 1", "")
+		assert.Equal(t, "/v1.0/actors/Account/acc%2F1/reminders/r%201", got.path)
+	})
+
+	t.Run("unix domain socket", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("unix domain sockets are not supported on Windows")
+		}
+
+		socket := t.TempDir()
+		s, l := getTestSocketServerFunc(handler, "testapp", socket)
+		go s.Serve(l)
+		t.Cleanup(func() { s.Close() })
+
+		// The port is not listened on, so the request must use the socket.
+		client := &Standalone{
+			process: &mockDaprProcess{
+				Lo: []ListOutput{{AppID: "testapp", HTTPPort: 1}},
+			},
+		}
+
+		res, err := client.GetActorState("testapp", "Account", "acc-1", "balance", socket)
+		require.NoError(t, err)
+		assert.Equal(t, "42", res)
+
+		types, err := client.ActorTypes("testapp", socket)
+		require.NoError(t, err)
+		require.Len(t, types, 1)
+	})
+}
@@ -0,0 +1,439 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	path_filepath "path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/utils"
+)
+
+// addon is optional infrastructure which `dapr init --with` runs in a
+// container, next to the components to use it.
+type addon struct {
+	name        string
+	description string
+	// image is the Docker Hub image. With --image-registry the image is
+	// looked up as <registry>/dapr/3rdparty/<image>, for example
+	// <registry>/dapr/3rdparty/postgres:16-alpine.
+	image string
+	// ports are published on the same port of the host.
+	ports []int
+	env   []string
+	// hostEnv returns the environment variables which depend on the host on
+	// which the container is reachable.
+	hostEnv func(host string) []string
+	args    []string
+	// healthCmd is run by the container runtime to report the health of the
+	// container.
+	healthCmd string
+	// healthPort is the published port of an HTTP health endpoint, which the
+	// CLI checks for images without a shell to run healthCmd in. It can only
+	// be checked when the ports are published, that is without --network.
+	healthPort int
+	// otlp is set for tracing backends, to which the default configuration
+	// sends the traces over OTLP gRPC on port 4317 instead of to Zipkin.
+	otlp bool
+	// components returns the components to write, given the host on which
+	// the container is reachable.
+	components func(host string) []component
+}
+
+// AddonOutput describes an add-on and the state of its container.
+type AddonOutput struct {
+	Name        string `csv:"NAME"        json:"name"        yaml:"name"`
+	Description string `csv:"DESCRIPTION" json:"description" yaml:"description"`
+	Image       string `csv:"IMAGE"       json:"image"       yaml:"image"`
+	Ports       string `csv:"PORTS"       json:"ports"       yaml:"ports"`
+	Status      string `csv:"STATUS"      json:"status"      yaml:"status"`
+}
+
+var addons = []addon{
+	{
+		name:        "kafka",
+		description: "Apache Kafka broker with a Kafka pub/sub component",
+		image:       "docker.io/apache/kafka:3.8.0",
+		ports:       []int{9092},
+		// The image only uses its default configuration without any KAFKA_
+		// variable, so the whole single node configuration is given.
+		hostEnv: func(host string) []string {
+			return []string{
+				"KAFKA_NODE_ID=1",
+				"KAFKA_PROCESS_ROLES=broker,controller",
+				"KAFKA_LISTENERS=PLAINTEXT://:9092,CONTROLLER://:9093",
+				// Clients are redirected to the advertised listener, which
+				// must be the broker of the component.
+				"KAFKA_ADVERTISED_LISTENERS=PLAINTEXT://" + host + ":9092",
+				"KAFKA_CONTROLLER_LISTENER_NAMES=CONTROLLER",
+				"KAFKA_LISTENER_SECURITY_PROTOCOL_MAP=CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT",
+				"KAFKA_CONTROLLER_QUORUM_VOTERS=1@localhost:9093",
+				"KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR=1",
+				"KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR=1",
+				"KAFKA_TRANSACTION_STATE_LOG_MIN_ISR=1",
+				"KAFKA_GROUP_INITIAL_REBALANCE_DELAY_MS=0",
+			}
+		},
+		healthCmd: "/opt/kafka/bin/kafka-topics.sh --bootstrap-server localhost:9092 --list",
+		components: func(host string) []component {
+			return []component{newComponent("kafka-pubsub", "pubsub.kafka",
+				componentMetadataItem{Name: "brokers", Value: host + ":9092"},
+				componentMetadataItem{Name: "authType", Value: "none"},
+			)}
+		},
+	},
+	{
+		name:        "postgres",
+		description: "PostgreSQL database with a PostgreSQL state store component",
+		image:       "docker.io/postgres:16-alpine",
+		ports:       []int{5432},
+		env:         []string{"POSTGRES_PASSWORD=postgres"},
+		healthCmd:   "pg_isready -U postgres",
+		components: func(host string) []component {
+			return []component{newComponent("postgres-statestore", "state.postgresql",
+				componentMetadataItem{Name: "connectionString", Value: fmt.Sprintf("host=%s user=postgres password=postgres port=5432 connect_timeout=10 database=postgres", host)},
+			)}
+		},
+	},
+	{
+		name:        "jaeger",
+		description: "Jaeger tracing backend, receiving OTLP traces on ports 4317 and 4318",
+		image:       "docker.io/jaegertracing/all-in-one:1.62.0",
+		ports:       []int{16686, 4317, 4318},
+		env:         []string{"COLLECTOR_OTLP_ENABLED=true"},
+		healthCmd:   "wget -q -O /dev/null http://localhost:14269/",
+		otlp:        true,
+	},
+	{
+		name:        "otel-collector",
+		description: "OpenTelemetry collector, receiving OTLP traces on ports 4317 and 4318 and logging them",
+		image:       "docker.io/otel/opentelemetry-collector-contrib:0.112.0",
+		ports:       []int{4317, 4318, 13133},
+		// The configuration is given inline, as the receivers of the default
+		// one only listen on localhost.
+		args: []string{
+			"--config=yaml:receivers::otlp::protocols::grpc::endpoint: 0.0.0.0:4317",
+			"--config=yaml:receivers::otlp::protocols::http::endpoint: 0.0.0.0:4318",
+			"--config=yaml:exporters::debug::verbosity: basic",
+			"--config=yaml:extensions::health_check::endpoint: 0.0.0.0:13133",
+			"--config=yaml:service::extensions: [health_check]",
+			"--config=yaml:service::pipelines::traces::receivers: [otlp]",
+			"--config=yaml:service::pipelines::traces::exporters: [debug]",
+		},
+		// The image has no shell.
+		healthPort: 13133,
+		otlp:       true,
+	},
+	{
+		name:        "rabbitmq",
+		description: "RabbitMQ broker with a RabbitMQ pub/sub component",
+		image:       "docker.io/rabbitmq:3-management-alpine",
+		ports:       []int{5672, 15672},
+		// The guest user can only connect from within the container.
+		env:       []string{"RABBITMQ_DEFAULT_USER=dapr", "RABBITMQ_DEFAULT_PASS=dapr"},
+		healthCmd: "rabbitmq-diagnostics -q ping",
+		components: func(host string) []component {
+			return []component{newComponent("rabbitmq-pubsub", "pubsub.rabbitmq",
+				componentMetadataItem{Name: "connectionString", Value: fmt.Sprintf("amqp://dapr:dapr@%s:5672", host)},
+			)}
+		},
+	},
+	{
+		name:        "mosquitto",
+		description: "Eclipse Mosquitto MQTT broker with an MQTT pub/sub component",
+		image:       "docker.io/eclipse-mosquitto:2",
+		ports:       []int{1883},
+		// Mosquitto 2 only accepts local connections without a configuration.
+		args:      []string{"mosquitto", "-c", "/mosquitto-no-auth.conf"},
+		healthCmd: "mosquitto_sub -t '$SYS/broker/uptime' -C 1 -W 5",
+		components: func(host string) []component {
+			return []component{newComponent("mqtt-pubsub", "pubsub.mqtt3",
+				componentMetadataItem{Name: "url", Value: fmt.Sprintf("tcp://%s:1883", host)},
+				componentMetadataItem{Name: "qos", Value: "1"},
+			)}
+		},
+	},
+}
+
+// AddonNames returns the names of the available add-ons.
+func AddonNames() []string {
+	names := make([]string, 0, len(addons))
+	for _, a := range addons {
+		names = append(names, a.name)
+	}
+	return names
+}
+
+func (a addon) containerName() string {
+	return "dapr_" + strings.ReplaceAll(a.name, "-", "_")
+}
+
+func (a addon) imageName(imageRegistryURL string) (string, error) {
+	// Only the images of Redis and Zipkin are mirrored on GHCR, so the Docker
+	// Hub image is used unless a private registry is given.
+	return resolveImageURI(daprImageInfo{
+		ghcrImageName:      "dapr/3rdparty/" + strings.TrimPrefix(a.image, dockerURI+"/"),
+		dockerHubImageName: a.image,
+		imageRegistryURL:   imageRegistryURL,
+		imageRegistryName:  dockerContainerRegistryName,
+	})
+}
+
+// host returns the host on which the container is reachable by daprd.
+func (a addon) host(dockerNetwork string) string {
+	if dockerNetwork != "" {
+		// Default to network scoped alias of the container name when a dockerNetwork is specified.
+		return a.containerName()
+	}
+	return daprDefaultHost
+}
+
+func (a addon) container(image, host string) containerSpec {
+	ports := make([]string, 0, len(a.ports))
+	for _, port := range a.ports {
+		ports = append(ports, fmt.Sprintf("%v:%v", port, port))
+	}
+	env := a.env
+	if a.hostEnv != nil {
+		env = append(slices.Clone(env), a.hostEnv(host)...)
+	}
+	return containerSpec{
+		name:      a.containerName(),
+		image:     image,
+		env:       env,
+		ports:     ports,
+		healthCmd: a.healthCmd,
+		args:      a.args,
+	}
+}
+
+func newComponent(name, componentType string, metadata ...componentMetadataItem) component {
+	c := component{
+		APIVersion: "dapr.io/v1alpha1",
+		Kind:       "Component",
+	}
+	c.Metadata.Name = name
+	c.Spec.Type = componentType
+	c.Spec.Version = "v1"
+	c.Spec.Metadata = metadata
+	return c
+}
+
+// parseAddons looks up the add-ons given to --with and checks that they do
+// not publish the same ports.
+func parseAddons(names []string) ([]addon, error) {
+	var selected []addon
+	ports := map[int]string{}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		a, ok := lookupAddon(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown add-on %q. Available add-ons are %s", name, strings.Join(AddonNames(), ", "))
+		}
+		if containsAddon(selected, name) {
+			continue
+		}
+		for _, port := range a.ports {
+			if other, ok := ports[port]; ok {
+				return nil, fmt.Errorf("add-ons %s and %s cannot be installed together, both use port %d", other, name, port)
+			}
+			ports[port] = name
+		}
+		selected = append(selected, a)
+	}
+	return selected, nil
+}
+
+func lookupAddon(name string) (addon, bool) {
+	for _, a := range addons {
+		if a.name == name {
+			return a, true
+		}
+	}
+	return addon{}, false
+}
+
+func containsAddon(list []addon, name string) bool {
+	for _, a := range list {
+		if a.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func runAddons(wg *sync.WaitGroup, errorChan chan<- error, info initInfo) {
+	defer wg.Done()
+
+	if info.slimMode || isAirGapInit {
+		return
+	}
+
+	for _, a := range info.addons {
+		if err := runAddon(a, info); err != nil {
+			errorChan <- err
+			return
+		}
+	}
+	errorChan <- nil
+}
+
+func runAddon(a addon, info initInfo) error {
+	runtimeCmd := utils.GetContainerRuntimeCmd(info.containerRuntime)
+	containerName := utils.CreateContainerName(a.containerName(), info.dockerNetwork)
+
+	exists, err := confirmContainerIsRunningOrExists(containerName, false, runtimeCmd)
+	if err != nil {
+		return err
+	}
+
+	args := []string{}
+	if exists {
+		// do not create container again if it exists.
+		args = append(args, "start", containerName)
+	} else {
+		image, err := a.imageName(info.imageRegistryURL)
+		if err != nil {
+			return err
+		}
+
+		args = a.container(image, a.host(info.dockerNetwork)).runArgs(info.dockerNetwork)
+	}
+
+	_, err = utils.RunCmdAndWait(runtimeCmd, args...)
+	if err != nil {
+		if !isContainerRunError(err) {
+			return parseContainerRuntimeError(a.name, err)
+		}
+		return fmt.Errorf("%s %s failed with: %w", runtimeCmd, args, err)
+	}
+
+	if a.components == nil {
+		return nil
+	}
+
+	for _, c := range a.components(a.host(info.dockerNetwork)) {
+		b, err := yaml.Marshal(&c)
+		if err != nil {
+			return err
+		}
+		filePath := path_filepath.Join(GetDaprComponentsPath(info.installDir), c.Metadata.Name+".yaml")
+		if err = checkAndOverWriteFile(filePath, b); err != nil {
+			return fmt.Errorf("error creating %s component file: %w", c.Metadata.Name, err)
+		}
+	}
+	return nil
+}
+
+// removeAddonContainers removes the containers of the add-ons which exist.
+func removeAddonContainers(containerErrs []error, dockerNetwork, runtimeCmd string) []error {
+	for _, a := range addons {
+		containerName := utils.CreateContainerName(a.containerName(), dockerNetwork)
+		if exists, _ := confirmContainerIsRunningOrExists(containerName, false, runtimeCmd); exists {
+			containerErrs = removeDockerContainer(containerErrs, a.containerName(), dockerNetwork, runtimeCmd)
+		}
+	}
+	return containerErrs
+}
+
+// ListAddons lists the available add-ons and the status of their containers.
+func ListAddons(dockerNetwork, containerRuntime string) ([]AddonOutput, error) {
+	containerRuntime = strings.TrimSpace(containerRuntime)
+	runtimeAvailable := utils.IsContainerRuntimeInstalled(containerRuntime)
+	if !runtimeAvailable {
+		print.WarningStatusEvent(os.Stderr, "WARNING: could not connect to %s, the status of the add-ons is unknown", containerRuntime)
+	}
+	runtimeCmd := utils.GetContainerRuntimeCmd(containerRuntime)
+
+	list := make([]AddonOutput, 0, len(addons))
+	for _, a := range addons {
+		ports := make([]string, 0, len(a.ports))
+		for _, port := range a.ports {
+			ports = append(ports, strconv.Itoa(port))
+		}
+
+		status := "unknown"
+		if runtimeAvailable {
+			status = addonStatus(runtimeCmd, utils.CreateContainerName(a.containerName(), dockerNetwork))
+			if status == "running" && a.healthPort > 0 && dockerNetwork == "" {
+				status += " (" + addonHTTPHealth(a.healthPort) + ")"
+			}
+		}
+
+		list = append(list, AddonOutput{
+			Name:        a.name,
+			Description: a.description,
+			Image:       a.image,
+			Ports:       strings.Join(ports, ","),
+			Status:      status,
+		})
+	}
+	return list, nil
+}
+
+// addonStatus returns the state of a container and its health, for example
+// "running (healthy)", or "not installed" when the container does not exist.
+func addonStatus(runtimeCmd, containerName string) string {
+	if exists, _ := confirmContainerIsRunningOrExists(containerName, false, runtimeCmd); !exists {
+		return "not installed"
+	}
+
+	out, err := utils.RunCmdAndWait(runtimeCmd, "inspect", "--format",
+		"{{.State.Status}}{{if .State.Health}} ({{.State.Health.Status}}){{end}}", containerName)
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(out)
+}
+
+// addonHTTPHealth checks the HTTP health endpoint published on the port of
+// the host.
+func addonHTTPHealth(port int) string {
+	client := http.Client{Timeout: 2 * time.Second}
+	resp, err := client.Get(fmt.Sprintf("http://%s:%d/", daprDefaultHost, port))
+	if err != nil {
+		return "unhealthy"
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "unhealthy"
+	}
+	return "healthy"
+}
+
+// addonTracingHost returns the host of the selected tracing add-on the
+// default configuration sends OTLP traces to, or an empty string when traces
+// are sent to Zipkin.
+func addonTracingHost(selected []addon, dockerNetwork string) string {
+	for _, a := range selected {
+		if a.otlp {
+			return a.host(dockerNetwork)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAddons(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         []string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "no add-ons",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "add-ons are case insensitive and deduplicated",
+			input:    []string{"Kafka", " postgres ", "kafka", ""},
+			expected: []string{"kafka", "postgres"},
+		},
+		{
+			name:          "unknown add-on",
+			input:         []string{"kafka", "mongodb"},
+			expectedError: `unknown add-on "mongodb"`,
+		},
+		{
+			name:          "add-ons with the same ports",
+			input:         []string{"jaeger", "otel-collector"},
+			expectedError: "add-ons jaeger and otel-collector cannot be installed together, both use port 4317",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected, err := parseAddons(tc.input)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, a := range selected {
+				names = append(names, a.name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestAddonDefinitions(t *testing.T) {
+	for _, a := range addons {
+		t.Run(a.name, func(t *testing.T) {
+			assert.NotEmpty(t, a.description)
+			assert.NotEmpty(t, a.ports)
+			assert.Regexp(t, `^dapr_[a-z_]+$`, a.containerName())
+			// Every add-on reports its health, through the container
+			// runtime or a published health endpoint.
+			assert.True(t, a.healthCmd != "" || slices.Contains(a.ports, a.healthPort), "no health check")
+
+			if a.components == nil {
+				return
+			}
+			for _, c := range a.components(DaprRedisContainerName) {
+				assert.NotEmpty(t, c.Metadata.Name)
+				assert.NotEmpty(t, c.Spec.Type)
+				require.NotEmpty(t, c.Spec.Metadata)
+				assert.Contains(t, c.Spec.Metadata[0].Value, DaprRedisContainerName)
+			}
+		})
+	}
+}
+
+func TestAddonContainerHost(t *testing.T) {
+	kafka, ok := lookupAddon("kafka")
+	require.True(t, ok)
+
+	// The broker advertises the host of the component, so clients are not
+	// redirected to another host.
+	for _, network := range []string{"", "mynet"} {
+		host := kafka.host(network)
+		spec := kafka.container("apache/kafka", host)
+		assert.Contains(t, spec.env, "KAFKA_ADVERTISED_LISTENERS=PLAINTEXT://"+host+":9092")
+		assert.Equal(t, host+":9092", kafka.components(host)[0].Spec.Metadata[0].Value)
+	}
+	assert.Equal(t, "dapr_kafka", kafka.host("mynet"))
+	assert.Equal(t, daprDefaultHost, kafka.host(""))
+}
+
+func TestAddonTracingHost(t *testing.T) {
+	selected, err := parseAddons([]string{"postgres"})
+	require.NoError(t, err)
+	assert.Empty(t, addonTracingHost(selected, ""))
+
+	for _, name := range []string{"jaeger", "otel-collector"} {
+		selected, err = parseAddons([]string{"postgres", name})
+		require.NoError(t, err)
+		assert.Equal(t, daprDefaultHost, addonTracingHost(selected, ""))
+		assert.Equal(t, selected[1].containerName(), addonTracingHost(selected, "mynet"))
+	}
+}
+
+func TestAddonImageName(t *testing.T) {
+	postgres, ok := lookupAddon("postgres")
+	require.True(t, ok)
+
+	image, err := postgres.imageName("")
+	require.NoError(t, err)
+	assert.Equal(t, "docker.io/postgres:16-alpine", image)
+
+	image, err = postgres.imageName("localhost:5000")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:5000/dapr/3rdparty/postgres:16-alpine", image)
+}
@@ -29,6 +29,15 @@ type bundleDetails struct {
 	ImageSubDir       *string `json:"dockerImageSubDir"`
 	DaprImageName     *string `json:"daprImageName"`
 	DaprImageFileName *string `json:"daprImageFileName"`
+	// The Redis and Zipkin images are optional, `dapr init --from-dir` does
+	// not run them.
+	RedisImageName      *string `json:"redisImageName,omitempty"`
+	RedisImageFileName  *string `json:"redisImageFileName,omitempty"`
+	ZipkinImageName     *string `json:"zipkinImageName,omitempty"`
+	ZipkinImageFileName *string `json:"zipkinImageFileName,omitempty"`
+	// Checksums are the SHA-256 checksums of the binary archives and image
+	// files of the bundle, by file name.
+	Checksums map[string]string `json:"checksums,omitempty"`
 }
 
 // readAndParseDetails reads the file in detailsFilePath and tries to parse it into the bundleDetails struct.
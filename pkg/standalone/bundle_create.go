@@ -0,0 +1,307 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	path_filepath "path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/dapr/cli/pkg/print"
+	cli_ver "github.com/dapr/cli/pkg/version"
+	"github.com/dapr/cli/utils"
+)
+
+const (
+	bundleBinarySubDir = "dist"
+	bundleImageSubDir  = "docker"
+	// bundleRootDirName is the top level directory of tar.gz bundles.
+	bundleRootDirName = "daprbundle"
+
+	cliFilePrefix     = "dapr"
+	cliGitHubRepoName = "cli"
+)
+
+// BundleOptions configures the creation of an air-gap bundle.
+type BundleOptions struct {
+	RuntimeVersion   string
+	CLIVersion       string
+	OS               string
+	Arch             string
+	ContainerRuntime string
+	// Output is the directory the bundle is written to, or a .tar.gz file.
+	Output string
+	Verify VerifyMode
+}
+
+type bundleImage struct {
+	image    string
+	fileName **string
+	name     **string
+}
+
+// CreateBundle creates an air-gap bundle which can be installed with `dapr
+// init --from-dir`.
+func CreateBundle(opts BundleOptions) error {
+	containerRuntime := strings.TrimSpace(opts.ContainerRuntime)
+	if !utils.IsContainerRuntimeInstalled(containerRuntime) {
+		return fmt.Errorf("could not connect to %s. %s may not be installed or running", containerRuntime, containerRuntime)
+	}
+	runtimeCmd := utils.GetContainerRuntimeCmd(containerRuntime)
+
+	runtimeVersion := strings.TrimPrefix(opts.RuntimeVersion, "v")
+	if runtimeVersion == latestVersion {
+		var err error
+		runtimeVersion, err = cli_ver.GetLatestVersion(cli_ver.DaprImageRef(""))
+		if err != nil {
+			return fmt.Errorf("cannot get the latest release version: '%w'. Try specifying --runtime-version=<desired_version>", err)
+		}
+	}
+
+	hasScheduler, err := isSchedulerIncluded(runtimeVersion)
+	if err != nil {
+		return err
+	}
+
+	archive := strings.HasSuffix(opts.Output, ".tar.gz")
+	bundleDir := opts.Output
+	if archive {
+		tmp, err := os.MkdirTemp("", "daprbundle")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		bundleDir = path_filepath.Join(tmp, bundleRootDirName)
+	} else if entries, err := os.ReadDir(bundleDir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("output directory %s is not empty", bundleDir)
+	}
+
+	for _, dir := range []string{bundleBinarySubDir, bundleImageSubDir} {
+		if err = os.MkdirAll(path_filepath.Join(bundleDir, dir), 0o755); err != nil {
+			return err
+		}
+	}
+
+	print.InfoStatusEvent(os.Stdout, "Creating bundle of runtime version %s for %s/%s", runtimeVersion, opts.OS, opts.Arch)
+
+	details := bundleDetails{
+		RuntimeVersion: &runtimeVersion,
+		CLIVersion:     &opts.CLIVersion,
+		BinarySubDir:   new(string),
+		ImageSubDir:    new(string),
+		Checksums:      map[string]string{},
+	}
+	*details.BinarySubDir = bundleBinarySubDir
+	*details.ImageSubDir = bundleImageSubDir
+
+	binaries := []string{daprRuntimeFilePrefix, placementServiceFilePrefix}
+	if hasScheduler {
+		binaries = append(binaries, schedulerServiceFilePrefix)
+	}
+	// The Sentry binary is needed for `dapr init --slim --sentry`.
+	binaries = append(binaries, sentryServiceFilePrefix)
+	binaryDir := path_filepath.Join(bundleDir, bundleBinarySubDir)
+	for _, binary := range binaries {
+		stopSpinning := print.Spinner(os.Stdout, "Downloading %s...", binary)
+		err = downloadBundleBinary(binaryDir, runtimeVersion, binary, cli_ver.DaprGitHubRepo, opts, details.Checksums)
+		if err != nil {
+			stopSpinning(print.Failure)
+			return err
+		}
+		stopSpinning(print.Success)
+	}
+
+	if err = bundleCLI(bundleDir, opts); err != nil {
+		return err
+	}
+
+	daprImageName := daprDockerImageName + ":" + runtimeVersion
+	images := []bundleImage{
+		{image: daprImageName, name: &details.DaprImageName, fileName: &details.DaprImageFileName},
+		{image: redisDockerImageName, name: &details.RedisImageName, fileName: &details.RedisImageFileName},
+		{image: zipkinDockerImageName, name: &details.ZipkinImageName, fileName: &details.ZipkinImageFileName},
+	}
+	for _, img := range images {
+		stopSpinning := print.Spinner(os.Stdout, "Saving image %s...", img.image)
+		fileName, err := saveBundleImage(runtimeCmd, path_filepath.Join(bundleDir, bundleImageSubDir), img.image, opts.Arch)
+		if err != nil {
+			stopSpinning(print.Failure)
+			return err
+		}
+		stopSpinning(print.Success)
+
+		image := img.image
+		*img.name, *img.fileName = &image, &fileName
+		details.Checksums[fileName], err = fileChecksum(path_filepath.Join(bundleDir, bundleImageSubDir, fileName))
+		if err != nil {
+			return err
+		}
+	}
+
+	b, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(path_filepath.Join(bundleDir, bundleDetailsFileName), b, 0o644); err != nil { //nolint:gosec
+		return err
+	}
+
+	if archive {
+		if err = archiveBundle(bundleDir, opts.Output); err != nil {
+			return fmt.Errorf("error writing %s: %w", opts.Output, err)
+		}
+	}
+
+	print.SuccessStatusEvent(os.Stdout, "Bundle written to %s. Install it with `dapr init --from-dir <bundle directory>`", opts.Output)
+	return nil
+}
+
+// bundleBinaryName returns the release archive name of a binary for the
+// target platform of a bundle.
+func bundleBinaryName(binaryFilePrefix, goos, goarch string) string {
+	ext := "tar.gz"
+	if goos == daprWindowsOS {
+		ext = "zip"
+	}
+	return fmt.Sprintf("%s_%s_%s.%s", binaryFilePrefix, goos, goarch, ext)
+}
+
+func downloadBundleBinary(dir, version, binaryFilePrefix, githubRepo string, opts BundleOptions, checksums map[string]string) error {
+	fileURL := releaseAssetURL(version, bundleBinaryName(binaryFilePrefix, opts.OS, opts.Arch), githubRepo)
+
+	archive, err := downloadFile(dir, fileURL)
+	if err != nil {
+		return fmt.Errorf("error downloading %s binary: %w", binaryFilePrefix, err)
+	}
+	if err = verifyDownload(archive, fileURL, opts.Verify); err != nil {
+		return err
+	}
+
+	checksums[path_filepath.Base(archive)], err = fileChecksum(archive)
+	return err
+}
+
+// bundleCLI adds the CLI binary of the target platform to the root of the
+// bundle, so the bundle can be installed without a CLI.
+func bundleCLI(bundleDir string, opts BundleOptions) error {
+	if _, err := semver.NewVersion(opts.CLIVersion); err != nil {
+		print.WarningStatusEvent(os.Stdout, "WARNING: the CLI binary is not added to the bundle, CLI version %q is not a release", opts.CLIVersion)
+		return nil
+	}
+
+	tmp, err := os.MkdirTemp("", "dapr-cli")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	checksums := map[string]string{}
+	version := strings.TrimPrefix(opts.CLIVersion, "v")
+	if err = downloadBundleBinary(tmp, version, cliFilePrefix, cliGitHubRepoName, opts, checksums); err != nil {
+		return err
+	}
+
+	archive := path_filepath.Join(tmp, bundleBinaryName(cliFilePrefix, opts.OS, opts.Arch))
+	if opts.OS == daprWindowsOS {
+		_, err = unzipExternalFile(archive, bundleDir, cliFilePrefix)
+	} else {
+		_, err = untarExternalFile(archive, bundleDir, cliFilePrefix)
+	}
+	return err
+}
+
+// saveBundleImage pulls an image for the target architecture and saves it
+// into the image directory. It returns the file name of the saved image.
+func saveBundleImage(runtimeCmd, dir, image, arch string) (string, error) {
+	if _, err := utils.RunCmdAndWait(runtimeCmd, "pull", "--platform", "linux/"+arch, image); err != nil {
+		return "", fmt.Errorf("error pulling image %s: %w", image, err)
+	}
+
+	fileName := bundleImageFileName(image)
+	if _, err := utils.RunCmdAndWait(runtimeCmd, "save", "-o", path_filepath.Join(dir, fileName), image); err != nil {
+		return "", fmt.Errorf("error saving image %s: %w", image, err)
+	}
+
+	return fileName, nil
+}
+
+// bundleImageFileName returns the file name an image is saved to, for example
+// daprio-dapr-1.16.0.tar for docker.io/daprio/dapr:1.16.0.
+func bundleImageFileName(image string) string {
+	image = strings.TrimPrefix(image, dockerURI+"/")
+	return strings.NewReplacer("/", "-", ":", "-").Replace(image) + ".tar"
+}
+
+// archiveBundle writes the bundle directory into a tar.gz file.
+func archiveBundle(bundleDir, output string) (err error) {
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(output)
+		}
+	}()
+
+	gzw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gzw)
+
+	root := path_filepath.Dir(bundleDir)
+	err = path_filepath.Walk(bundleDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, err := path_filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		header.Name = path_filepath.ToSlash(rel)
+		if err = tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		_, err = io.Copy(tw, in)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(tw.Close(), gzw.Close())
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/ptr"
+)
+
+func TestBundleNames(t *testing.T) {
+	assert.Equal(t, "daprd_linux_arm64.tar.gz", bundleBinaryName("daprd", "linux", "arm64"))
+	assert.Equal(t, "placement_windows_amd64.zip", bundleBinaryName("placement", "windows", "amd64"))
+
+	assert.Equal(t, "daprio-dapr-1.16.0.tar", bundleImageFileName("docker.io/daprio/dapr:1.16.0"))
+	assert.Equal(t, "redis-6.tar", bundleImageFileName("docker.io/redis:6"))
+	assert.Equal(t, "openzipkin-zipkin.tar", bundleImageFileName("docker.io/openzipkin/zipkin"))
+}
+
+func TestArchiveBundle(t *testing.T) {
+	bundleDir := filepath.Join(t.TempDir(), bundleRootDirName)
+	require.NoError(t, os.MkdirAll(filepath.Join(bundleDir, bundleBinarySubDir), 0o755))
+
+	details := bundleDetails{
+		RuntimeVersion:    ptr.Of("1.16.0"),
+		CLIVersion:        ptr.Of("1.16.0"),
+		BinarySubDir:      ptr.Of(bundleBinarySubDir),
+		ImageSubDir:       ptr.Of(bundleImageSubDir),
+		DaprImageName:     ptr.Of("docker.io/daprio/dapr:1.16.0"),
+		DaprImageFileName: ptr.Of("daprio-dapr-1.16.0.tar"),
+		Checksums:         map[string]string{"daprd_linux_amd64.tar.gz": "abcd"},
+	}
+	b, err := json.Marshal(details)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(bundleDir, bundleDetailsFileName), b, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(bundleDir, bundleBinarySubDir, "daprd_linux_amd64.tar.gz"), []byte("daprd"), 0o600))
+
+	// The written details are accepted by `dapr init --from-dir`.
+	var parsed bundleDetails
+	require.NoError(t, parsed.readAndParseDetails(filepath.Join(bundleDir, bundleDetailsFileName)))
+	assert.Equal(t, details.Checksums, parsed.Checksums)
+
+	output := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	require.NoError(t, archiveBundle(bundleDir, output))
+
+	f, err := os.Open(output)
+	require.NoError(t, err)
+	defer f.Close()
+	gzr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if header.Typeflag == tar.TypeReg {
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[header.Name] = string(content)
+		}
+	}
+
+	assert.Equal(t, map[string]string{
+		"daprbundle/details.json":                  string(b),
+		"daprbundle/dist/daprd_linux_amd64.tar.gz": "daprd",
+	}, files)
+}
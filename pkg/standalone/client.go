@@ -13,6 +13,8 @@ limitations under the License.
 
 package standalone
 
+import "github.com/dapr/cli/pkg/api"
+
 type DaprProcess interface {
 	List() ([]ListOutput, error)
 }
@@ -25,6 +27,18 @@ type Client interface {
 	Invoke(appID, method string, data []byte, verb string, socket string) (string, error)
 	// Publish is used to publish event to a topic in a pubsub for an app ID.
 	Publish(publishAppID, pubsubName, topic string, payload []byte, socket string, metadata map[string]interface{}) error
+	// ActorTypes returns the actor types and active actor counts hosted by an app ID.
+	ActorTypes(appID, socket string) ([]api.MetadataActiveActorsCount, error)
+	// InvokeActor invokes a method on an actor through the sidecar of an app ID.
+	InvokeActor(appID, actorType, actorID, method string, data []byte, verb, socket string) (string, error)
+	// GetActorState reads a key of an actor's state through the sidecar of an app ID.
+	GetActorState(appID, actorType, actorID, key, socket string) (string, error)
+	// CreateActorReminder creates or updates an actor reminder through the sidecar of an app ID.
+	CreateActorReminder(appID, actorType, actorID, name string, reminder ActorReminder, socket string) error
+	// GetActorReminder gets an actor reminder through the sidecar of an app ID.
+	GetActorReminder(appID, actorType, actorID, name, socket string) (*ActorReminder, error)
+	// DeleteActorReminder deletes an actor reminder through the sidecar of an app ID.
+	DeleteActorReminder(appID, actorType, actorID, name, socket string) error
 }
 
 type Standalone struct {
@@ -27,6 +27,7 @@ const (
 
 	defaultDaprBinDirName       = "bin"
 	defaultComponentsDirName    = "components"
+	defaultCertsDirName         = "certs"
 	defaultSchedulerDirName     = "scheduler"
 	defaultSchedulerDataDirName = "data"
 )
@@ -81,6 +82,12 @@ func GetDaprComponentsPath(daprDir string) string {
 	return path_filepath.Join(daprDir, defaultComponentsDirName)
 }
 
+// GetDaprCertsPath returns the directory holding the root and issuer
+// certificates of the self-hosted Sentry service.
+func GetDaprCertsPath(daprDir string) string {
+	return path_filepath.Join(daprDir, defaultCertsDirName)
+}
+
 func GetDaprConfigPath(daprDir string) string {
 	return path_filepath.Join(daprDir, DefaultConfigFileName)
 }
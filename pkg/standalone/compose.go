@@ -0,0 +1,481 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	path_filepath "path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	cli_ver "github.com/dapr/cli/pkg/version"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	// ExportCompose exports a Docker Compose file.
+	ExportCompose = "compose"
+
+	healthCheckInterval = "10s"
+
+	composeResourcesDir = "/resources"
+	composeConfigDir    = "/config"
+)
+
+// ComposeFile is a Docker Compose file, which can also be run with
+// podman-compose.
+type ComposeFile struct {
+	Services map[string]ComposeService `yaml:"services"`
+	Volumes  map[string]ComposeVolume  `yaml:"volumes,omitempty"`
+	Networks map[string]ComposeNetwork `yaml:"networks,omitempty"`
+}
+
+// ComposeService is a service of a Docker Compose file.
+type ComposeService struct {
+	Image         string                           `yaml:"image"`
+	ContainerName string                           `yaml:"container_name,omitempty"`
+	Restart       string                           `yaml:"restart,omitempty"`
+	Entrypoint    []string                         `yaml:"entrypoint,omitempty"`
+	Command       []string                         `yaml:"command,omitempty"`
+	User          string                           `yaml:"user,omitempty"`
+	Environment   []string                         `yaml:"environment,omitempty"`
+	Ports         []string                         `yaml:"ports,omitempty"`
+	Volumes       []string                         `yaml:"volumes,omitempty"`
+	ExtraHosts    []string                         `yaml:"extra_hosts,omitempty"`
+	Healthcheck   *ComposeHealthcheck              `yaml:"healthcheck,omitempty"`
+	Networks      map[string]ComposeServiceNetwork `yaml:"networks,omitempty"`
+	NetworkMode   string                           `yaml:"network_mode,omitempty"`
+	DependsOn     []string                         `yaml:"depends_on,omitempty"`
+}
+
+// ComposeHealthcheck is the health check of a Docker Compose service.
+type ComposeHealthcheck struct {
+	Test     []string `yaml:"test"`
+	Interval string   `yaml:"interval,omitempty"`
+}
+
+// ComposeServiceNetwork attaches a Docker Compose service to a network.
+type ComposeServiceNetwork struct {
+	Aliases []string `yaml:"aliases,omitempty"`
+}
+
+// ComposeVolume is a named volume of a Docker Compose file.
+type ComposeVolume struct {
+	Name string `yaml:"name,omitempty"`
+}
+
+// ComposeNetwork is a network of a Docker Compose file.
+type ComposeNetwork struct {
+	Name     string `yaml:"name,omitempty"`
+	External bool   `yaml:"external,omitempty"`
+}
+
+// containerSpec describes how `dapr init` runs a container, so the same
+// container can be started with the container runtime or exported.
+type containerSpec struct {
+	// name is the container name without the network suffix, which is also
+	// the network alias.
+	name       string
+	image      string
+	entrypoint string
+	user       string
+	env        []string
+	// ports are published as host:container when no network is given.
+	ports   []string
+	volumes []string
+	// extraHosts are added to the hosts file of the container, as host:ip.
+	extraHosts []string
+	healthCmd  string
+	args       []string
+}
+
+// runArgs returns the arguments of the container runtime to run the
+// container.
+func (c containerSpec) runArgs(network string) []string {
+	args := []string{
+		"run",
+		"--name", utils.CreateContainerName(c.name, network),
+		"--restart", "always",
+		"-d",
+	}
+	if c.entrypoint != "" {
+		args = append(args, "--entrypoint", c.entrypoint)
+	}
+	if c.user != "" {
+		args = append(args, "--user", c.user)
+	}
+	for _, env := range c.env {
+		args = append(args, "-e", env)
+	}
+	for _, volume := range c.volumes {
+		args = append(args, "--volume", volume)
+	}
+	for _, host := range c.extraHosts {
+		args = append(args, "--add-host", host)
+	}
+	if c.healthCmd != "" {
+		args = append(args, "--health-cmd", c.healthCmd, "--health-interval", healthCheckInterval)
+	}
+
+	if network != "" {
+		args = append(args,
+			"--network", network,
+			"--network-alias", c.name)
+	} else {
+		for _, port := range c.ports {
+			args = append(args, "-p", port)
+		}
+	}
+
+	args = append(args, c.image)
+	return append(args, c.args...)
+}
+
+// composeService returns the Docker Compose service of the container.
+func (c containerSpec) composeService(network string) ComposeService {
+	svc := ComposeService{
+		Image:         c.image,
+		ContainerName: utils.CreateContainerName(c.name, network),
+		Restart:       "always",
+		Command:       c.args,
+		User:          c.user,
+		Environment:   c.env,
+		Volumes:       c.volumes,
+		ExtraHosts:    c.extraHosts,
+	}
+	if c.entrypoint != "" {
+		svc.Entrypoint = []string{c.entrypoint}
+	}
+	if c.healthCmd != "" {
+		svc.Healthcheck = &ComposeHealthcheck{
+			Test:     []string{"CMD-SHELL", c.healthCmd},
+			Interval: healthCheckInterval,
+		}
+	}
+	if network != "" {
+		svc.Networks = map[string]ComposeServiceNetwork{network: {Aliases: []string{c.name}}}
+	} else {
+		svc.Ports = c.ports
+	}
+	return svc
+}
+
+// ExportInit writes the containers `dapr init` would start as a Docker
+// Compose file, without installing anything.
+func ExportInit(opts InitOptions, format string, w io.Writer) error {
+	if format != ExportCompose {
+		return fmt.Errorf("unsupported export format %q. Supported formats are %s", format, ExportCompose)
+	}
+	if opts.SlimMode || strings.TrimSpace(opts.FromDir) != "" || opts.Sentry {
+		return errors.New("--export cannot be used with --slim, --from-dir or --sentry")
+	}
+	info, err := newInitInfo(opts)
+	if err != nil {
+		return err
+	}
+
+	compose, err := initComposeFile(info)
+	if err != nil {
+		return err
+	}
+
+	b, err := yaml.Marshal(compose)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// initComposeFile returns the Docker Compose file of the containers of an
+// init.
+func initComposeFile(info initInfo) (*ComposeFile, error) {
+	// daprd runs in a container too, in which localhost is its own container,
+	// so the scheduler broadcasts its service name.
+	if info.schedulerOverrideBroadcastHostPort == nil {
+		info.schedulerOverrideBroadcastHostPort = ptr.Of(DaprSchedulerContainerName + ":50006")
+	}
+
+	specs, err := initContainers(info)
+	if err != nil {
+		return nil, err
+	}
+	hasScheduler, err := isSchedulerIncluded(info.runtimeVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	compose := &ComposeFile{Services: map[string]ComposeService{}}
+	for _, spec := range specs {
+		compose.Services[spec.name] = spec.composeService(info.dockerNetwork)
+	}
+
+	if hasScheduler && info.schedulerVolume != nil && isNamedVolume(*info.schedulerVolume) {
+		// Keep the name of the volume, so it is shared with `dapr init`.
+		compose.Volumes = map[string]ComposeVolume{*info.schedulerVolume: {Name: *info.schedulerVolume}}
+	}
+	if info.dockerNetwork != "" {
+		compose.Networks = map[string]ComposeNetwork{info.dockerNetwork: {Name: info.dockerNetwork, External: true}}
+	}
+	return compose, nil
+}
+
+// initContainers returns the containers an init starts.
+func initContainers(info initInfo) ([]containerSpec, error) {
+	if info.slimMode {
+		return nil, nil
+	}
+
+	var daprImage string
+	if isAirGapInit {
+		daprImage = info.bundleDet.getDaprImageName()
+	} else {
+		image, err := resolveImageURI(daprImageInfo{
+			ghcrImageName:      daprGhcrImageName,
+			dockerHubImageName: daprDockerImageName,
+			imageRegistryURL:   info.imageRegistryURL,
+			imageRegistryName:  defaultImageRegistryName,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The image is not pulled, so there is no fallback to Docker Hub.
+		daprImage, err = getDaprImageWithTag(image, info.runtimeVersion, info.imageVariant)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	specs := []containerSpec{placementContainer(info, daprImage)}
+	hasScheduler, err := isSchedulerIncluded(info.runtimeVersion)
+	if err != nil {
+		return nil, err
+	}
+	if hasScheduler {
+		specs = append(specs, schedulerContainer(info, daprImage))
+	}
+	if info.sentry {
+		specs = append(specs, sentryContainer(info, daprImage))
+	}
+	// Redis, Zipkin and the add-ons are not installed from a bundle.
+	if isAirGapInit {
+		return specs, nil
+	}
+
+	redisImage, err := resolveImageURI(redisImageInfo(info.redisStack, info.imageRegistryURL, defaultImageRegistryName))
+	if err != nil {
+		return nil, err
+	}
+	zipkinImage, err := resolveImageURI(daprImageInfo{
+		ghcrImageName:      zipkinGhcrImageName,
+		dockerHubImageName: zipkinDockerImageName,
+		imageRegistryURL:   info.imageRegistryURL,
+		imageRegistryName:  defaultImageRegistryName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	specs = append(specs, redisContainer(redisImage), zipkinContainer(zipkinImage))
+
+	for _, a := range info.addons {
+		image, err := a.imageName(info.imageRegistryURL)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, a.container(image, a.host(info.dockerNetwork)))
+	}
+	return specs, nil
+}
+
+// isNamedVolume returns whether a volume is a named volume rather than a
+// path on the host.
+func isNamedVolume(volume string) bool {
+	return !strings.ContainsAny(volume, `/\:`) && !strings.HasPrefix(volume, "~")
+}
+
+// AppComposeServices returns the Docker Compose services of an app run with
+// `dapr run -f`: the app, which runs the given image, and daprd, which shares
+// the network of the app and is started with the arguments of `dapr run`.
+// The resources paths and the configuration file are mounted into the daprd
+// container, and placement and scheduler are reached through the services
+// exported by `dapr init --export compose`.
+func AppComposeServices(config RunConfig, image, runtimeVersion string) (ComposeService, ComposeService, error) {
+	if strings.TrimSpace(image) == "" {
+		return ComposeService{}, ComposeService{}, fmt.Errorf("containerImage is required to export app %q", config.AppID)
+	}
+	daprdImage, err := getDaprImageWithTag(cli_ver.DaprDefaultImage, runtimeVersion, "")
+	if err != nil {
+		return ComposeService{}, ComposeService{}, err
+	}
+
+	// Only the ports which are given are published, as the defaults are the
+	// same for all the apps.
+	var ports []string
+	for _, port := range []int{config.AppPort, config.HTTPPort, config.GRPCPort} {
+		if port > 0 {
+			ports = append(ports, fmt.Sprintf("%v:%v", port, port))
+		}
+	}
+	setComposeDefaults(&config)
+
+	if err = checkComposeDefaultPaths(config); err != nil {
+		return ComposeService{}, ComposeService{}, err
+	}
+	volumes, err := mountComposePaths(&config)
+	if err != nil {
+		return ComposeService{}, ComposeService{}, err
+	}
+
+	env := config.getEnv()
+	sort.Strings(env)
+
+	app := ComposeService{
+		Image:         image,
+		ContainerName: config.AppID,
+		Environment:   env,
+		Ports:         ports,
+	}
+	daprd := ComposeService{
+		Image:         daprdImage,
+		ContainerName: config.AppID + "-dapr",
+		Entrypoint:    []string{"./daprd"},
+		Command:       config.getArgs(),
+		Volumes:       volumes,
+		// daprd reaches the app on localhost, as it does with `dapr run`.
+		NetworkMode: "service:" + config.AppID,
+		DependsOn:   []string{config.AppID},
+	}
+	return app, daprd, nil
+}
+
+// setComposeDefaults sets the values `dapr run` would otherwise pick when
+// daprd starts. The ports are the defaults of daprd, as every daprd has the
+// network of its own app.
+func setComposeDefaults(config *RunConfig) {
+	config.SetDefaultFromSchema()
+	if config.AppPort < 0 {
+		config.AppPort = 0
+	}
+	for _, p := range []struct {
+		port  *int
+		value int
+	}{
+		{&config.HTTPPort, 3500},
+		{&config.GRPCPort, 50001},
+		{&config.MetricsPort, 9090},
+		{&config.InternalGRPCPort, 50002},
+		{&config.ProfilePort, 7777},
+	} {
+		if *p.port <= 0 {
+			*p.port = p.value
+		}
+	}
+	if config.MaxConcurrency < 1 {
+		config.MaxConcurrency = -1
+	}
+
+	if isComposeLocalAddress(config.PlacementHostAddr) {
+		config.PlacementHostAddr = ptr.Of(DaprPlacementContainerName + ":50005")
+	}
+	// The scheduler address is only unset for versions without the scheduler.
+	if config.SchedulerHostAddress != nil && isComposeLocalAddress(config.SchedulerHostAddress) {
+		config.SchedulerHostAddress = ptr.Of(DaprSchedulerContainerName + ":50006")
+	}
+}
+
+// isComposeLocalAddress returns whether an address is unset or on
+// localhost, which is the container itself in Docker Compose.
+func isComposeLocalAddress(address *string) bool {
+	if address == nil {
+		return true
+	}
+	host := strings.TrimSpace(*address)
+	if host == "" {
+		// An empty address disables the service.
+		return false
+	}
+	if i := strings.LastIndex(host, ":"); i != -1 {
+		host = host[:i]
+	}
+	return host == daprDefaultHost || host == "127.0.0.1"
+}
+
+// checkComposeDefaultPaths rejects the components and configuration of the
+// installation, which are used when the run file gives none. They connect to
+// Redis and Zipkin on localhost, which is the app container in Docker Compose.
+func checkComposeDefaultPaths(config RunConfig) error {
+	installDir, err := GetDaprRuntimePath(config.DaprdInstallPath)
+	if err != nil {
+		return err
+	}
+	defaults := map[string]string{
+		GetDaprComponentsPath(installDir): "resourcesPaths",
+		GetDaprConfigPath(installDir):     "configFilePath",
+	}
+
+	paths := append([]string{config.ComponentsPath, config.ConfigFile}, config.ResourcesPaths...)
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		abs, err := path_filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		if field, ok := defaults[abs]; ok {
+			return fmt.Errorf("app %q uses %s of the self-hosted installation, which connects to localhost, the app container in Docker Compose. "+
+				"Set %s in the run file to files using the service names, for example dapr_redis:6379 and dapr_zipkin:9411", config.AppID, path, field)
+		}
+	}
+	return nil
+}
+
+// mountComposePaths replaces the resources paths and the configuration file
+// with their paths in the container, and returns the volumes to mount them.
+func mountComposePaths(config *RunConfig) ([]string, error) {
+	resourcesPaths := config.ResourcesPaths
+	if len(resourcesPaths) == 0 && config.ComponentsPath != "" {
+		resourcesPaths = []string{config.ComponentsPath}
+	}
+	config.ResourcesPaths = nil
+	config.ComponentsPath = ""
+
+	var volumes []string
+	for i, path := range resourcesPaths {
+		abs, err := path_filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		dir := composeResourcesDir + "/" + strconv.Itoa(i)
+		volumes = append(volumes, abs+":"+dir+":ro")
+		config.ResourcesPaths = append(config.ResourcesPaths, dir)
+	}
+
+	if config.ConfigFile != "" {
+		abs, err := path_filepath.Abs(config.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		file := composeConfigDir + "/" + path_filepath.Base(abs)
+		volumes = append(volumes, abs+":"+file+":ro")
+		config.ConfigFile = file
+	}
+	return volumes, nil
+}
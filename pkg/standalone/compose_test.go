@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	path_filepath "path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerSpecRunArgs(t *testing.T) {
+	volume := "dapr_scheduler"
+	info := initInfo{
+		runtimeVersion:  "1.16.0",
+		schedulerVolume: &volume,
+	}
+
+	t.Run("scheduler with a network", func(t *testing.T) {
+		info := info
+		info.dockerNetwork = "dapr-net"
+		args := schedulerContainer(info, "daprio/dapr:1.16.0").runArgs(info.dockerNetwork)
+		assert.Equal(t, []string{
+			"run",
+			"--name", "dapr_scheduler_dapr-net",
+			"--restart", "always",
+			"-d",
+			"--entrypoint", "./scheduler",
+			"--volume", "dapr_scheduler:/var/lock",
+			"--network", "dapr-net",
+			"--network-alias", "dapr_scheduler",
+			"daprio/dapr:1.16.0",
+			"--etcd-data-dir=/var/lock/dapr/scheduler",
+			"--override-broadcast-host-port=localhost:50006",
+		}, args)
+
+		// In Docker Compose, daprd reaches the scheduler by its service
+		// name.
+		info.imageRegistryURL = "localhost:5000"
+		compose, err := initComposeFile(info)
+		require.NoError(t, err)
+		assert.Contains(t, compose.Services[DaprSchedulerContainerName].Command, "--override-broadcast-host-port=dapr_scheduler:50006")
+	})
+
+	t.Run("placement publishes its ports", func(t *testing.T) {
+		if runtime.GOOS == daprWindowsOS {
+			t.Skip("placement uses port 6050 on Windows")
+		}
+		args := placementContainer(info, "daprio/dapr:1.16.0").runArgs("")
+		assert.Equal(t, []string{
+			"run",
+			"--name", "dapr_placement",
+			"--restart", "always",
+			"-d",
+			"--entrypoint", "./placement",
+			"-p", "50005:50005",
+			"-p", "58080:8080",
+			"-p", "59090:9090",
+			"daprio/dapr:1.16.0",
+		}, args)
+	})
+}
+
+func TestInitComposeFile(t *testing.T) {
+	volume := "dapr_scheduler"
+	postgres, ok := lookupAddon("postgres")
+	require.True(t, ok)
+
+	compose, err := initComposeFile(initInfo{
+		runtimeVersion:   "1.17.0",
+		imageRegistryURL: "localhost:5000",
+		schedulerVolume:  &volume,
+		addons:           []addon{postgres},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, compose.Services, 5)
+	placement := compose.Services[DaprPlacementContainerName]
+	assert.Equal(t, "localhost:5000/dapr/dapr:1.17.0", placement.Image)
+	assert.Equal(t, []string{"./placement"}, placement.Entrypoint)
+
+	scheduler := compose.Services[DaprSchedulerContainerName]
+	assert.Equal(t, []string{"dapr_scheduler:/var/lock"}, scheduler.Volumes)
+	assert.Contains(t, scheduler.Command, "--override-broadcast-host-port=dapr_scheduler:50006")
+	assert.Contains(t, scheduler.Command, "--etcd-client-listen-address=0.0.0.0")
+	assert.Equal(t, map[string]ComposeVolume{"dapr_scheduler": {Name: "dapr_scheduler"}}, compose.Volumes)
+
+	assert.Equal(t, "localhost:5000/dapr/3rdparty/redis:6", compose.Services[DaprRedisContainerName].Image)
+	assert.Equal(t, []string{"6379:6379"}, compose.Services[DaprRedisContainerName].Ports)
+
+	pg := compose.Services["dapr_postgres"]
+	assert.Equal(t, []string{"POSTGRES_PASSWORD=postgres"}, pg.Environment)
+	require.NotNil(t, pg.Healthcheck)
+	assert.Equal(t, []string{"CMD-SHELL", "pg_isready -U postgres"}, pg.Healthcheck.Test)
+	assert.Empty(t, compose.Networks)
+
+	t.Run("with a network", func(t *testing.T) {
+		compose, err := initComposeFile(initInfo{
+			runtimeVersion:   "1.17.0",
+			imageRegistryURL: "localhost:5000",
+			dockerNetwork:    "dapr-net",
+		})
+		require.NoError(t, err)
+
+		redis := compose.Services[DaprRedisContainerName]
+		assert.Equal(t, "dapr_redis_dapr-net", redis.ContainerName)
+		assert.Empty(t, redis.Ports)
+		assert.Equal(t, map[string]ComposeServiceNetwork{"dapr-net": {Aliases: []string{DaprRedisContainerName}}}, redis.Networks)
+		assert.Equal(t, map[string]ComposeNetwork{"dapr-net": {Name: "dapr-net", External: true}}, compose.Networks)
+	})
+}
+
+func TestAppComposeServices(t *testing.T) {
+	resourcesDir := t.TempDir()
+	scheduler := "localhost"
+	config := RunConfig{
+		AppID:   "orders",
+		AppPort: 8080,
+		SharedRunConfig: SharedRunConfig{
+			ResourcesPaths:       []string{resourcesDir},
+			SchedulerHostAddress: &scheduler,
+			Env:                  map[string]string{"LOG_LEVEL": "debug"},
+		},
+	}
+
+	app, daprd, err := AppComposeServices(config, "orders:latest", "1.16.0")
+	require.NoError(t, err)
+
+	assert.Equal(t, "orders:latest", app.Image)
+	assert.Equal(t, []string{"8080:8080"}, app.Ports)
+	assert.Contains(t, app.Environment, "APP_ID=orders")
+	assert.Contains(t, app.Environment, "DAPR_HTTP_PORT=3500")
+	assert.Contains(t, app.Environment, "LOG_LEVEL=debug")
+
+	assert.Equal(t, "daprio/dapr:1.16.0", daprd.Image)
+	assert.Equal(t, "service:orders", daprd.NetworkMode)
+	assert.Equal(t, []string{"orders"}, daprd.DependsOn)
+	assert.Equal(t, []string{path_filepath.Clean(resourcesDir) + ":/resources/0:ro"}, daprd.Volumes)
+	assert.Subset(t, daprd.Command, []string{
+		"--app-id", "orders",
+		"--resources-path", "/resources/0",
+		"--placement-host-address", "dapr_placement:50005",
+		"--scheduler-host-address", "dapr_scheduler:50006",
+	})
+
+	t.Run("the resources of the installation are rejected", func(t *testing.T) {
+		config := config
+		config.DaprdInstallPath = t.TempDir()
+		installDir, err := GetDaprRuntimePath(config.DaprdInstallPath)
+		require.NoError(t, err)
+		config.ResourcesPaths = []string{GetDaprComponentsPath(installDir)}
+		_, _, err = AppComposeServices(config, "orders:latest", "1.16.0")
+		assert.ErrorContains(t, err, "resourcesPaths")
+
+		config.ResourcesPaths = []string{resourcesDir}
+		config.ConfigFile = GetDaprConfigPath(installDir)
+		_, _, err = AppComposeServices(config, "orders:latest", "1.16.0")
+		assert.ErrorContains(t, err, "configFilePath")
+	})
+
+	t.Run("the image is required", func(t *testing.T) {
+		_, _, err := AppComposeServices(config, "", "1.16.0")
+		assert.ErrorContains(t, err, "containerImage is required")
+	})
+}
+
+func TestComposeExportsTogether(t *testing.T) {
+	volume := "dapr_scheduler"
+	for _, network := range []string{"", "dapr-net"} {
+		t.Run("network "+network, func(t *testing.T) {
+			compose, err := initComposeFile(initInfo{
+				runtimeVersion:   "1.16.0",
+				imageRegistryURL: "localhost:5000",
+				schedulerVolume:  &volume,
+				dockerNetwork:    network,
+			})
+			require.NoError(t, err)
+
+			scheduler := "localhost"
+			_, daprd, err := AppComposeServices(RunConfig{
+				AppID: "orders",
+				SharedRunConfig: SharedRunConfig{
+					ResourcesPaths:       []string{t.TempDir()},
+					SchedulerHostAddress: &scheduler,
+				},
+			}, "orders:latest", "1.16.0")
+			require.NoError(t, err)
+
+			// daprd connects to the services of the init export, and the
+			// scheduler broadcasts the address daprd connects to.
+			for _, flag := range []string{"--placement-host-address", "--scheduler-host-address"} {
+				i := slices.Index(daprd.Command, flag)
+				require.NotEqual(t, -1, i, flag)
+				host, _, ok := strings.Cut(daprd.Command[i+1], ":")
+				require.True(t, ok)
+				assert.Contains(t, compose.Services, host)
+			}
+			i := slices.Index(daprd.Command, "--scheduler-host-address")
+			assert.Contains(t, compose.Services[DaprSchedulerContainerName].Command, "--override-broadcast-host-port="+daprd.Command[i+1])
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"fmt"
+	"os"
+	path_filepath "path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/dapr/cli/pkg/doctor"
+	"github.com/dapr/cli/utils"
+)
+
+const (
+	// staleLogAge is the age after which the log files of `dapr run -f` are
+	// reported.
+	staleLogAge = 7 * 24 * time.Hour
+	logsDirName = "logs"
+)
+
+// DoctorOptions configures the checks of a self-hosted installation.
+type DoctorOptions struct {
+	DaprInstallPath  string
+	DockerNetwork    string
+	ContainerRuntime string
+	CLIVersion       string
+	// LogsDir is the directory with the log files of `dapr run -f`.
+	LogsDir string
+}
+
+// Doctor checks a self-hosted installation.
+func Doctor(opts DoctorOptions) []doctor.Check {
+	var checks []doctor.Check
+
+	runtimeVersion, versionCheck := checkRuntimeVersion(opts.DaprInstallPath, opts.CLIVersion)
+	checks = append(checks, versionCheck)
+
+	containerRuntime := strings.TrimSpace(opts.ContainerRuntime)
+	runtimeCheck := "Container runtime"
+	if !utils.IsContainerRuntimeInstalled(containerRuntime) {
+		checks = append(checks, doctor.Failf(runtimeCheck, "could not connect to %s. It may not be installed or running", containerRuntime))
+	} else {
+		checks = append(checks, doctor.Passf(runtimeCheck, "%s is reachable", containerRuntime))
+		checks = append(checks, checkContainers(runtimeVersion, opts.DockerNetwork, containerRuntime)...)
+	}
+
+	return append(checks, checkStaleLogs(opts.LogsDir, time.Now()))
+}
+
+// checkRuntimeVersion checks that daprd is installed with the version of the
+// CLI, and returns the version of daprd.
+func checkRuntimeVersion(daprInstallPath, cliVersion string) (string, doctor.Check) {
+	name := "Runtime version"
+	out, err := GetRuntimeVersion(daprInstallPath)
+	if err != nil {
+		return "", doctor.Failf(name, "daprd is not installed. Run `dapr init`")
+	}
+	runtimeVersion := strings.TrimSpace(out)
+	return runtimeVersion, compareVersions(name, runtimeVersion, cliVersion)
+}
+
+// compareVersions checks that the runtime and the CLI have the same major
+// and minor version.
+func compareVersions(name, runtimeVersion, cliVersion string) doctor.Check {
+	runtimeV, err := semver.NewVersion(runtimeVersion)
+	if err != nil {
+		return doctor.Warnf(name, "daprd %s is not a release version", runtimeVersion)
+	}
+	cliV, err := semver.NewVersion(cliVersion)
+	if err != nil {
+		return doctor.Warnf(name, "daprd %s cannot be compared with CLI %s", runtimeVersion, cliVersion)
+	}
+	if runtimeV.Major() != cliV.Major() || runtimeV.Minor() != cliV.Minor() {
+		return doctor.Warnf(name, "daprd %s does not match CLI %s. Run `dapr upgrade --runtime-version %d.%d.x`", runtimeVersion, cliVersion, cliV.Major(), cliV.Minor())
+	}
+	return doctor.Passf(name, "daprd %s matches CLI %s", runtimeVersion, cliVersion)
+}
+
+// checkContainers checks that the containers of `dapr init` are running, and
+// that the ports of the containers which are not running are free.
+func checkContainers(runtimeVersion, dockerNetwork, containerRuntime string) []doctor.Check {
+	info := initInfo{runtimeVersion: runtimeVersion, dockerNetwork: dockerNetwork}
+	specs := []containerSpec{placementContainer(info, "")}
+	// The scheduler is checked when the version of daprd is unknown.
+	if hasScheduler, err := isSchedulerIncluded(runtimeVersion); err != nil || hasScheduler {
+		specs = append(specs, schedulerContainer(info, ""))
+	}
+	specs = append(specs, redisContainer(""), zipkinContainer(""))
+
+	runtimeCmd := utils.GetContainerRuntimeCmd(containerRuntime)
+	var checks []doctor.Check
+	for _, spec := range specs {
+		containerName := utils.CreateContainerName(spec.name, dockerNetwork)
+		name := "Container " + containerName
+
+		running, err := confirmContainerIsRunningOrExists(containerName, true, runtimeCmd)
+		if err != nil {
+			checks = append(checks, doctor.Failf(name, "%s", err))
+			continue
+		}
+		if running {
+			checks = append(checks, doctor.Passf(name, "running"))
+			continue
+		}
+
+		exists, _ := confirmContainerIsRunningOrExists(containerName, false, runtimeCmd)
+		if exists {
+			checks = append(checks, doctor.Failf(name, "not running. Start it with `%s start %s`", runtimeCmd, containerName))
+		} else {
+			checks = append(checks, doctor.Warnf(name, "not installed. Run `dapr init`, unless Dapr was initialized with --slim"))
+		}
+
+		// Published ports are only used without a network.
+		if dockerNetwork == "" {
+			checks = append(checks, checkContainerPorts(spec))
+		}
+	}
+	return checks
+}
+
+// checkContainerPorts checks that the host ports of a container are free.
+func checkContainerPorts(spec containerSpec) doctor.Check {
+	name := "Ports of " + spec.name
+	ports := make([]int, 0, len(spec.ports))
+	for _, p := range spec.ports {
+		hostPort, _, _ := strings.Cut(p, ":")
+		port, err := strconv.Atoi(hostPort)
+		if err == nil {
+			ports = append(ports, port)
+		}
+	}
+
+	if err := checkPorts(ports...); err != nil {
+		return doctor.Failf(name, "%s. Stop the process using it before running `dapr init`", err)
+	}
+	return doctor.Passf(name, "%s are free", strings.Trim(fmt.Sprint(ports), "[]"))
+}
+
+// checkStaleLogs reports the log files of `dapr run -f` older than
+// staleLogAge.
+func checkStaleLogs(logsDir string, now time.Time) doctor.Check {
+	name := "Logs"
+	entries, err := os.ReadDir(logsDir)
+	if os.IsNotExist(err) {
+		return doctor.Passf(name, "no logs in %s", logsDir)
+	} else if err != nil {
+		return doctor.Warnf(name, "could not read %s: %s", logsDir, err)
+	}
+
+	var count int
+	var size int64
+	for _, e := range entries {
+		fi, err := e.Info()
+		if err != nil || fi.IsDir() {
+			continue
+		}
+		if now.Sub(fi.ModTime()) > staleLogAge {
+			count++
+			size += fi.Size()
+		}
+	}
+	if count > 0 {
+		return doctor.Warnf(name, "%d log files in %s are older than %d days (%d KiB). Remove them if they are no longer needed",
+			count, logsDir, int(staleLogAge.Hours()/24), size>>10)
+	}
+	return doctor.Passf(name, "no stale logs in %s", logsDir)
+}
+
+// GetDaprLogsPath returns the directory of the log files of the apps of
+// `dapr run -f` run from a directory.
+func GetDaprLogsPath(appDir string) string {
+	return path_filepath.Join(appDir, DefaultDaprDirName, logsDirName)
+}
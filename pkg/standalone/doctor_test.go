@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"net"
+	"os"
+	path_filepath "path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/cli/pkg/doctor"
+)
+
+func TestCompareVersions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		runtimeVersion string
+		cliVersion     string
+		expected       doctor.Result
+	}{
+		{"same minor version", "1.16.2", "1.16.0", doctor.Pass},
+		{"different minor version", "1.15.5", "1.16.0", doctor.Warn},
+		{"edge CLI", "1.16.0", "edge", doctor.Warn},
+		{"dev runtime", "dev", "1.16.0", doctor.Warn},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, compareVersions("Runtime version", tc.runtimeVersion, tc.cliVersion).Result)
+		})
+	}
+}
+
+func TestCheckContainerPorts(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer ln.Close()
+	port := ln.Addr().(*net.TCPAddr).Port
+
+	check := checkContainerPorts(containerSpec{name: "dapr_test", ports: []string{"0:9090"}})
+	assert.Equal(t, doctor.Pass, check.Result)
+
+	check = checkContainerPorts(containerSpec{name: "dapr_test", ports: []string{"0:9090", strconv.Itoa(port) + ":9090"}})
+	assert.Equal(t, doctor.Fail, check.Result)
+	assert.Contains(t, check.Message, strconv.Itoa(port))
+}
+
+func TestCheckStaleLogs(t *testing.T) {
+	logsDir := path_filepath.Join(t.TempDir(), "logs")
+	now := time.Now()
+
+	assert.Equal(t, doctor.Pass, checkStaleLogs(logsDir, now).Result)
+
+	require.NoError(t, os.MkdirAll(logsDir, 0o755))
+	logFile := path_filepath.Join(logsDir, "app_daprd_20240101000000.log")
+	require.NoError(t, os.WriteFile(logFile, []byte("log"), 0o600))
+	assert.Equal(t, doctor.Pass, checkStaleLogs(logsDir, now).Result)
+
+	old := now.Add(-2 * staleLogAge)
+	require.NoError(t, os.Chtimes(logFile, old, old))
+	check := checkStaleLogs(logsDir, now)
+	assert.Equal(t, doctor.Warn, check.Result)
+	assert.Contains(t, check.Message, "1 log files")
+}
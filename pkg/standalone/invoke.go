@@ -41,15 +41,7 @@ func (s *Standalone) Invoke(appID, method string, data []byte, verb string, path
 			}
 			req.Header.Set("Content-Type", "application/json")
 
-			var httpc http.Client
-
-			if path != "" {
-				httpc.Transport = &http.Transport{
-					DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
-						return net.Dial("unix", utils.GetSocket(path, appID, "http"))
-					},
-				}
-			}
+			httpc := http.Client{Transport: socketTransport(path, appID)}
 
 			r, err := httpc.Do(req)
 			if err != nil {
@@ -63,6 +55,21 @@ func (s *Standalone) Invoke(appID, method string, data []byte, verb string, path
 	return "", fmt.Errorf("app ID %s not found", appID)
 }
 
+// socketTransport returns a transport dialing the HTTP Unix domain socket of
+// an app ID in the socket directory, or nil for the default transport when
+// no directory is given.
+func socketTransport(socket, appID string) http.RoundTripper {
+	if socket == "" {
+		return nil
+	}
+
+	return &http.Transport{
+		DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
+			return net.Dial("unix", utils.GetSocket(socket, appID, "http"))
+		},
+	}
+}
+
 func makeEndpoint(lo ListOutput, method string) string {
 	return fmt.Sprintf("http://127.0.0.1:%d/v%s/invoke/%s/method/%s", lo.HTTPPort, api.RuntimeAPIVersion, lo.AppID, method)
 }
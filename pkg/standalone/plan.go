@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"fmt"
+	"os"
+	path_filepath "path/filepath"
+
+	cli_ver "github.com/dapr/cli/pkg/version"
+	"github.com/dapr/cli/utils"
+)
+
+const (
+	// PlanCreate is the action on a file or container which does not exist.
+	PlanCreate = "create"
+	// PlanKeep is the action on a file which exists and is not overwritten.
+	PlanKeep = "keep"
+	// PlanUpdate is the action on a file which exists and is changed.
+	PlanUpdate = "update"
+	// PlanStart is the action on a container which exists and is started.
+	PlanStart = "start"
+)
+
+// InitPlan describes what `dapr init` would download, run and write.
+type InitPlan struct {
+	RuntimeVersion string          `json:"runtimeVersion"       yaml:"runtimeVersion"`
+	InstallDir     string          `json:"installDir"           yaml:"installDir"`
+	Binaries       []PlanBinary    `json:"binaries"             yaml:"binaries"`
+	Containers     []PlanContainer `json:"containers,omitempty" yaml:"containers,omitempty"`
+	Files          []PlanFile      `json:"files"                yaml:"files"`
+	// Warnings are the problems which would make the init fail.
+	Warnings []string `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+}
+
+// PlanBinary is a binary which would be downloaded, or extracted from a
+// bundle.
+type PlanBinary struct {
+	Name        string `json:"name"             yaml:"name"`
+	Source      string `json:"source"           yaml:"source"`
+	Destination string `json:"destination"      yaml:"destination"`
+	Verify      string `json:"verify,omitempty" yaml:"verify,omitempty"`
+}
+
+// PlanContainer is a container which would be started.
+type PlanContainer struct {
+	Name    string   `json:"name"              yaml:"name"`
+	Image   string   `json:"image"             yaml:"image"`
+	Action  string   `json:"action"            yaml:"action"`
+	Network string   `json:"network,omitempty" yaml:"network,omitempty"`
+	Ports   []string `json:"ports,omitempty"   yaml:"ports,omitempty"`
+	Volumes []string `json:"volumes,omitempty" yaml:"volumes,omitempty"`
+}
+
+// PlanFile is a file which would be written.
+type PlanFile struct {
+	Path   string `json:"path"   yaml:"path"`
+	Action string `json:"action" yaml:"action"`
+}
+
+// PlanInit returns what Init would do with the given options, without
+// changing anything on the machine or pulling images.
+func PlanInit(opts InitOptions) (*InitPlan, error) {
+	info, err := newInitInfo(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	binaries, err := planBinaries(info)
+	if err != nil {
+		return nil, err
+	}
+	plan := &InitPlan{
+		RuntimeVersion: info.runtimeVersion,
+		InstallDir:     info.installDir,
+		Binaries:       binaries,
+		Files:          planFiles(info),
+	}
+
+	daprdPath := binaryFilePathWithDir(getDaprBinPath(info.installDir), daprRuntimeFilePrefix)
+	if _, err = os.Stat(daprdPath); err == nil {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("%s exists, init would fail. %s", daprdPath, errInstallTemplate))
+	}
+
+	specs, err := initContainers(info)
+	if err != nil {
+		return nil, err
+	}
+	if len(specs) == 0 {
+		return plan, nil
+	}
+
+	runtimeCmd := utils.GetContainerRuntimeCmd(info.containerRuntime)
+	runtimeAvailable := utils.IsContainerRuntimeInstalled(info.containerRuntime)
+	if !runtimeAvailable {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("could not connect to %s. %s may not be installed or running", info.containerRuntime, info.containerRuntime))
+	}
+	for _, spec := range specs {
+		c := planContainer(spec, info.dockerNetwork)
+		if runtimeAvailable {
+			if exists, _ := confirmContainerIsRunningOrExists(c.Name, false, runtimeCmd); exists {
+				if !isReusedContainer(spec.name) {
+					plan.Warnings = append(plan.Warnings, fmt.Sprintf("%s container exists, init would fail. %s", c.Name, errInstallTemplate))
+				}
+				c.Action = PlanStart
+			}
+		}
+		plan.Containers = append(plan.Containers, c)
+	}
+	return plan, nil
+}
+
+// planBinaries returns the binaries an init installs into the bin directory.
+func planBinaries(info initInfo) ([]PlanBinary, error) {
+	prefixes := []string{daprRuntimeFilePrefix}
+	if info.slimMode {
+		prefixes = append(prefixes, placementServiceFilePrefix)
+		hasScheduler, err := isSchedulerIncluded(info.runtimeVersion)
+		if err != nil {
+			return nil, err
+		}
+		if hasScheduler {
+			prefixes = append(prefixes, schedulerServiceFilePrefix)
+		}
+		if info.sentry {
+			prefixes = append(prefixes, sentryServiceFilePrefix)
+		}
+	}
+
+	binDir := getDaprBinPath(info.installDir)
+	binaries := make([]PlanBinary, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		b := PlanBinary{
+			Name:        prefix,
+			Destination: binaryFilePathWithDir(binDir, prefix),
+			Verify:      string(info.verify),
+		}
+		if isAirGapInit {
+			b.Source = path_filepath.Join(info.fromDir, *info.bundleDet.BinarySubDir, binaryName(prefix))
+		} else {
+			b.Source = releaseFileURL(info.runtimeVersion, prefix, cli_ver.DaprGitHubRepo)
+		}
+		binaries = append(binaries, b)
+	}
+	return binaries, nil
+}
+
+// planFiles returns the files an init writes, besides the binaries. Files
+// which exist are kept, like checkAndOverWriteFile does.
+func planFiles(info initInfo) []PlanFile {
+	var files []PlanFile
+	add := func(path string) {
+		files = append(files, PlanFile{Path: path, Action: planFileAction(path)})
+	}
+
+	if version, err := normalizeRuntimeVersion(info.runtimeVersion); err == nil {
+		add(binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(info.installDir), version), daprRuntimeFilePrefix))
+	}
+
+	componentsDir := GetDaprComponentsPath(info.installDir)
+	if !info.slimMode && !isAirGapInit {
+		add(path_filepath.Join(componentsDir, pubSubYamlFileName))
+		add(path_filepath.Join(componentsDir, stateStoreYamlFileName))
+		for _, a := range info.addons {
+			if a.components == nil {
+				continue
+			}
+			for _, c := range a.components(daprDefaultHost) {
+				add(path_filepath.Join(componentsDir, c.Metadata.Name+".yaml"))
+			}
+		}
+	}
+
+	configPath := GetDaprConfigPath(info.installDir)
+	add(configPath)
+	if !info.sentry {
+		return files
+	}
+
+	// mTLS is also enabled in a configuration which exists.
+	if files[len(files)-1].Action == PlanKeep {
+		files[len(files)-1].Action = PlanUpdate
+	}
+	certsDir := GetDaprCertsPath(info.installDir)
+	// The chain is only generated when there is no root certificate.
+	certsAction := planFileAction(path_filepath.Join(certsDir, rootCertFileName))
+	for _, name := range []string{rootCertFileName, issuerCertFileName, issuerKeyFileName} {
+		files = append(files, PlanFile{Path: path_filepath.Join(certsDir, name), Action: certsAction})
+	}
+	return files
+}
+
+func planFileAction(path string) string {
+	if _, err := os.Stat(path); err == nil {
+		return PlanKeep
+	}
+	return PlanCreate
+}
+
+func planContainer(spec containerSpec, network string) PlanContainer {
+	c := PlanContainer{
+		Name:    utils.CreateContainerName(spec.name, network),
+		Image:   spec.image,
+		Action:  PlanCreate,
+		Network: network,
+		Volumes: spec.volumes,
+	}
+	// Published ports are only used without a network.
+	if network == "" {
+		c.Ports = spec.ports
+	}
+	return c
+}
+
+// isReusedContainer returns whether init starts a container which exists,
+// rather than failing.
+func isReusedContainer(name string) bool {
+	switch name {
+	case DaprPlacementContainerName, DaprSchedulerContainerName, DaprSentryContainerName:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"os"
+	path_filepath "path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cli_ver "github.com/dapr/cli/pkg/version"
+)
+
+func TestPlanBinaries(t *testing.T) {
+	setAirGapInit("")
+	installDir := t.TempDir()
+	binDir := getDaprBinPath(installDir)
+
+	t.Run("daprd is downloaded", func(t *testing.T) {
+		binaries, err := planBinaries(initInfo{installDir: installDir, runtimeVersion: "1.16.0", verify: VerifyStrict})
+		require.NoError(t, err)
+		require.Len(t, binaries, 1)
+		assert.Equal(t, PlanBinary{
+			Name:        daprRuntimeFilePrefix,
+			Source:      releaseFileURL("1.16.0", daprRuntimeFilePrefix, cli_ver.DaprGitHubRepo),
+			Destination: binaryFilePathWithDir(binDir, daprRuntimeFilePrefix),
+			Verify:      string(VerifyStrict),
+		}, binaries[0])
+	})
+
+	t.Run("slim mode downloads the control plane", func(t *testing.T) {
+		binaries, err := planBinaries(initInfo{installDir: installDir, runtimeVersion: "1.16.0", slimMode: true, sentry: true})
+		require.NoError(t, err)
+		names := make([]string, 0, len(binaries))
+		for _, b := range binaries {
+			names = append(names, b.Name)
+		}
+		assert.Equal(t, []string{daprRuntimeFilePrefix, placementServiceFilePrefix, schedulerServiceFilePrefix, sentryServiceFilePrefix}, names)
+	})
+
+	t.Run("slim mode without scheduler", func(t *testing.T) {
+		binaries, err := planBinaries(initInfo{installDir: installDir, runtimeVersion: "1.13.0", slimMode: true})
+		require.NoError(t, err)
+		assert.Len(t, binaries, 2)
+	})
+}
+
+func TestPlanFiles(t *testing.T) {
+	setAirGapInit("")
+	installDir := t.TempDir()
+	componentsDir := GetDaprComponentsPath(installDir)
+	require.NoError(t, os.MkdirAll(componentsDir, 0o755))
+	require.NoError(t, os.WriteFile(path_filepath.Join(componentsDir, pubSubYamlFileName), []byte("{}"), 0o600))
+	kafka, ok := lookupAddon("kafka")
+	require.True(t, ok)
+
+	t.Run("existing files are kept", func(t *testing.T) {
+		files := planFiles(initInfo{installDir: installDir, runtimeVersion: "1.16.0", addons: []addon{kafka}})
+		assert.Equal(t, []PlanFile{
+			{Path: binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(installDir), "1.16.0"), daprRuntimeFilePrefix), Action: PlanCreate},
+			{Path: path_filepath.Join(componentsDir, pubSubYamlFileName), Action: PlanKeep},
+			{Path: path_filepath.Join(componentsDir, stateStoreYamlFileName), Action: PlanCreate},
+			{Path: path_filepath.Join(componentsDir, "kafka-pubsub.yaml"), Action: PlanCreate},
+			{Path: GetDaprConfigPath(installDir), Action: PlanCreate},
+		}, files)
+	})
+
+	t.Run("slim mode only writes the configuration", func(t *testing.T) {
+		files := planFiles(initInfo{installDir: installDir, runtimeVersion: "1.16.0", slimMode: true})
+		require.Len(t, files, 2)
+		assert.Equal(t, GetDaprConfigPath(installDir), files[1].Path)
+	})
+
+	t.Run("sentry updates the configuration", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(GetDaprConfigPath(installDir), []byte("{}"), 0o600))
+		files := planFiles(initInfo{installDir: installDir, runtimeVersion: "1.16.0", slimMode: true, sentry: true})
+		require.Len(t, files, 5)
+		assert.Equal(t, PlanFile{Path: GetDaprConfigPath(installDir), Action: PlanUpdate}, files[1])
+		assert.Equal(t, PlanFile{Path: path_filepath.Join(GetDaprCertsPath(installDir), issuerKeyFileName), Action: PlanCreate}, files[4])
+	})
+}
+
+func TestPlanContainer(t *testing.T) {
+	spec := redisContainer("redis:6")
+
+	c := planContainer(spec, "")
+	assert.Equal(t, PlanContainer{Name: DaprRedisContainerName, Image: "redis:6", Action: PlanCreate, Ports: []string{"6379:6379"}}, c)
+
+	c = planContainer(spec, "dapr-net")
+	assert.Equal(t, "dapr_redis_dapr-net", c.Name)
+	assert.Equal(t, "dapr-net", c.Network)
+	assert.Empty(t, c.Ports)
+
+	assert.True(t, isReusedContainer(DaprRedisContainerName))
+	assert.False(t, isReusedContainer(DaprPlacementContainerName))
+}
@@ -91,7 +91,9 @@ type SharedRunConfig struct {
 	AppHealthThreshold int    `arg:"app-health-threshold" annotation:"dapr.io/app-health-threshold" ifneq:"0" yaml:"appHealthThreshold"`
 	EnableAPILogging   bool   `arg:"enable-api-logging" annotation:"dapr.io/enable-api-logging" yaml:"enableApiLogging"`
 	// Specifically omitted from annotations see https://github.com/dapr/cli/issues/1324 .
-	DaprdInstallPath    string            `yaml:"runtimePath"`
+	DaprdInstallPath string `yaml:"runtimePath"`
+	// RuntimeVersion selects an installed runtime version instead of the active one.
+	RuntimeVersion      string            `yaml:"runtimeVersion"`
 	Env                 map[string]string `yaml:"env"`
 	DaprdLogDestination LogDestType       `yaml:"daprdLogDestination"`
 	AppLogDestination   LogDestType       `yaml:"appLogDestination"`
@@ -574,13 +576,23 @@ func (config *RunConfig) GetAnnotations() map[string]string {
 }
 
 func GetDaprCommand(config *RunConfig) (*exec.Cmd, error) {
-	daprCMD, err := lookupBinaryFilePath(config.DaprdInstallPath, "daprd")
+	daprCMD, err := lookupRuntimeBinary(config.DaprdInstallPath, config.RuntimeVersion)
 	if err != nil {
 		return nil, err
 	}
 
 	args := config.getArgs()
 	cmd := exec.Command(daprCMD, args...)
+
+	// daprd verifies Sentry with the trust anchors of the local installation,
+	// unless they are given explicitly.
+	if _, ok := os.LookupEnv(trustAnchorsEnvVar); !ok && mtlsEndpoint(config.ConfigFile) != "" {
+		trustAnchors, err := sentryTrustAnchors(config.DaprdInstallPath)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Env = append(os.Environ(), trustAnchorsEnvVar+"="+string(trustAnchors))
+	}
 	return cmd, nil
 }
 
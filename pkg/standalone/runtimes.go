@@ -0,0 +1,325 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	path_filepath "path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+
+	cli_ver "github.com/dapr/cli/pkg/version"
+)
+
+// defaultRuntimesDirName is the directory in the bin directory holding a
+// sub-directory with the daprd binary of every installed runtime version.
+// The daprd binary in the bin directory itself is the active runtime.
+const defaultRuntimesDirName = "runtimes"
+
+// runtimeInstallStagingDirName is the directory in the bin directory a
+// runtime version is downloaded to before it is stored with the others.
+const runtimeInstallStagingDirName = ".install"
+
+// RuntimeOutput describes an installed runtime version.
+type RuntimeOutput struct {
+	Version string `csv:"VERSION" json:"version" yaml:"version"`
+	Active  bool   `csv:"ACTIVE"  json:"active"  yaml:"active"`
+	Path    string `csv:"PATH"    json:"path"    yaml:"path"`
+}
+
+func getDaprRuntimesPath(daprDir string) string {
+	return path_filepath.Join(getDaprBinPath(daprDir), defaultRuntimesDirName)
+}
+
+// normalizeRuntimeVersion trims the 'v' prefix of a version and makes sure it
+// can be used as a directory name.
+func normalizeRuntimeVersion(version string) (string, error) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" || version == "." || version == ".." || strings.ContainsAny(version, `/\`) {
+		return "", fmt.Errorf("invalid runtime version %q", version)
+	}
+	return version, nil
+}
+
+// activeRuntimeVersion returns the version of the daprd binary in the bin
+// directory, or an empty string if it is not installed.
+func activeRuntimeVersion(inputInstallPath string) string {
+	out, err := GetRuntimeVersion(inputInstallPath)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.TrimSpace(out), "v")
+}
+
+// ListRuntimes lists the installed runtime versions, newest first.
+func ListRuntimes(inputInstallPath string) ([]RuntimeOutput, error) {
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return nil, err
+	}
+	active := activeRuntimeVersion(inputInstallPath)
+
+	entries, err := os.ReadDir(getDaprRuntimesPath(daprDir))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	var list []RuntimeOutput
+	for _, entry := range entries {
+		binaryPath := binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(daprDir), entry.Name()), daprRuntimeFilePrefix)
+		if _, err := os.Stat(binaryPath); !entry.IsDir() || err != nil {
+			continue
+		}
+		list = append(list, RuntimeOutput{
+			Version: entry.Name(),
+			Active:  entry.Name() == active,
+			Path:    binaryPath,
+		})
+	}
+
+	// Installations from before versioned directories only have the active
+	// runtime in the bin directory.
+	if active != "" && !containsRuntime(list, active) {
+		list = append(list, RuntimeOutput{
+			Version: active,
+			Active:  true,
+			Path:    binaryFilePathWithDir(getDaprBinPath(daprDir), daprRuntimeFilePrefix),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return compareRuntimeVersions(list[i].Version, list[j].Version) > 0
+	})
+
+	return list, nil
+}
+
+func containsRuntime(list []RuntimeOutput, version string) bool {
+	for _, r := range list {
+		if r.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// compareRuntimeVersions compares semantic versions. Versions such as 'edge'
+// are newer than any release.
+func compareRuntimeVersions(a, b string) int {
+	va, erra := semver.NewVersion(a)
+	vb, errb := semver.NewVersion(b)
+	switch {
+	case erra == nil && errb == nil:
+		return va.Compare(vb)
+	case erra == nil:
+		return -1
+	case errb == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// UseRuntime makes an installed runtime version the active runtime, which is
+// used by `dapr run` unless a runtime version is given.
+func UseRuntime(inputInstallPath, version string) error {
+	version, err := normalizeRuntimeVersion(version)
+	if err != nil {
+		return err
+	}
+
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return err
+	}
+
+	active := activeRuntimeVersion(inputInstallPath)
+	if active == version {
+		return nil
+	}
+
+	source := binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(daprDir), version), daprRuntimeFilePrefix)
+	if _, err = os.Stat(source); err != nil {
+		return fmt.Errorf("runtime version %s is not installed. Install it with `dapr runtime install %s`", version, version)
+	}
+
+	// Keep the active runtime, so it can be switched back to.
+	if active != "" {
+		if err = storeRuntimeVersion(daprDir, active); err != nil {
+			return fmt.Errorf("error keeping runtime version %s: %w", active, err)
+		}
+	}
+
+	return copyBinary(source, binaryFilePathWithDir(getDaprBinPath(daprDir), daprRuntimeFilePrefix))
+}
+
+// InstallRuntime downloads the daprd binary of a runtime version next to the
+// installed ones, without changing the active runtime or the placement and
+// scheduler services. It returns the installed version, which is the latest
+// release when the version is latest.
+func InstallRuntime(inputInstallPath, version string, verify VerifyMode) (string, error) {
+	setAirGapInit("")
+	defaultImageRegistryName = ""
+
+	version, err := resolveRuntimeVersion(strings.TrimSpace(version), "")
+	if err != nil {
+		return "", err
+	}
+	version, err = normalizeRuntimeVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return "", err
+	}
+
+	target := binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(daprDir), version), daprRuntimeFilePrefix)
+	if _, err = os.Stat(target); err == nil {
+		return version, nil
+	}
+
+	// The binary is installed into a staging directory, so the daprd binary
+	// of the active runtime is left untouched.
+	info := initInfo{
+		installDir:     path_filepath.Join(getDaprBinPath(daprDir), runtimeInstallStagingDirName),
+		runtimeVersion: version,
+		verify:         verify,
+	}
+	stagingBinDir := getDaprBinPath(info.installDir)
+	if err = os.RemoveAll(info.installDir); err != nil {
+		return "", err
+	}
+	if err = prepareDaprInstallDir(stagingBinDir); err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(info.installDir)
+
+	if err = installBinary(version, daprRuntimeFilePrefix, cli_ver.DaprGitHubRepo, info); err != nil {
+		return "", err
+	}
+
+	return version, copyBinary(binaryFilePathWithDir(stagingBinDir, daprRuntimeFilePrefix), target)
+}
+
+// RemoveRuntime removes an installed runtime version. The active runtime
+// cannot be removed.
+func RemoveRuntime(inputInstallPath, version string) error {
+	version, err := normalizeRuntimeVersion(version)
+	if err != nil {
+		return err
+	}
+
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return err
+	}
+
+	if activeRuntimeVersion(inputInstallPath) == version {
+		return fmt.Errorf("runtime version %s is the active runtime. Switch to another version with `dapr runtime use` first", version)
+	}
+
+	dir := path_filepath.Join(getDaprRuntimesPath(daprDir), version)
+	if _, err = os.Stat(dir); err != nil {
+		return fmt.Errorf("runtime version %s is not installed", version)
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// storeRuntimeVersion copies the daprd binary in the bin directory into the
+// directory of its version, unless it is already there.
+func storeRuntimeVersion(daprDir, version string) error {
+	version, err := normalizeRuntimeVersion(version)
+	if err != nil {
+		return err
+	}
+
+	target := binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(daprDir), version), daprRuntimeFilePrefix)
+	if _, err = os.Stat(target); err == nil {
+		return nil
+	}
+
+	return copyBinary(binaryFilePathWithDir(getDaprBinPath(daprDir), daprRuntimeFilePrefix), target)
+}
+
+// copyBinary copies an executable through a temporary file, so a binary
+// which is running can be replaced.
+func copyBinary(source, target string) error {
+	if err := os.MkdirAll(path_filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := target + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err = out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err = makeExecutable(tmp); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, target)
+}
+
+// lookupRuntimeBinary returns the daprd binary of a runtime version, or of
+// the active runtime when no version is given.
+func lookupRuntimeBinary(inputInstallPath, runtimeVersion string) (string, error) {
+	if strings.TrimSpace(runtimeVersion) == "" {
+		return lookupBinaryFilePath(inputInstallPath, daprRuntimeFilePrefix)
+	}
+
+	version, err := normalizeRuntimeVersion(runtimeVersion)
+	if err != nil {
+		return "", err
+	}
+
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return "", err
+	}
+
+	binaryPath := binaryFilePathWithDir(path_filepath.Join(getDaprRuntimesPath(daprDir), version), daprRuntimeFilePrefix)
+	if _, err = os.Stat(binaryPath); err == nil {
+		return binaryPath, nil
+	}
+
+	if activeRuntimeVersion(inputInstallPath) == version {
+		return lookupBinaryFilePath(inputInstallPath, daprRuntimeFilePrefix)
+	}
+
+	return "", fmt.Errorf("runtime version %s is not installed. Run `dapr runtime list` to see the installed versions", version)
+}
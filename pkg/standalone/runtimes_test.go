@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFakeDaprd writes a daprd script printing the given version.
+func writeFakeDaprd(t *testing.T, path, version string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho "+version+"\n"), 0o755)) //nolint:gosec
+}
+
+func TestRuntimeVersions(t *testing.T) {
+	if runtime.GOOS == daprWindowsOS {
+		t.Skip("fake daprd binaries are shell scripts")
+	}
+
+	runtimePath := t.TempDir()
+	daprDir := filepath.Join(runtimePath, DefaultDaprDirName)
+	binDir := getDaprBinPath(daprDir)
+	writeFakeDaprd(t, binaryFilePathWithDir(binDir, daprRuntimeFilePrefix), "1.16.0")
+	writeFakeDaprd(t, binaryFilePathWithDir(filepath.Join(getDaprRuntimesPath(daprDir), "1.15.0"), daprRuntimeFilePrefix), "1.15.0")
+
+	t.Run("list includes the active runtime of older installations", func(t *testing.T) {
+		list, err := ListRuntimes(runtimePath)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, RuntimeOutput{Version: "1.16.0", Active: true, Path: binaryFilePathWithDir(binDir, daprRuntimeFilePrefix)}, list[0])
+		assert.Equal(t, "1.15.0", list[1].Version)
+		assert.False(t, list[1].Active)
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		path, err := lookupRuntimeBinary(runtimePath, "v1.15.0")
+		require.NoError(t, err)
+		assert.Equal(t, binaryFilePathWithDir(filepath.Join(getDaprRuntimesPath(daprDir), "1.15.0"), daprRuntimeFilePrefix), path)
+
+		path, err = lookupRuntimeBinary(runtimePath, "1.16.0")
+		require.NoError(t, err)
+		assert.Equal(t, binaryFilePathWithDir(binDir, daprRuntimeFilePrefix), path)
+
+		path, err = lookupRuntimeBinary(runtimePath, "")
+		require.NoError(t, err)
+		assert.Equal(t, binaryFilePathWithDir(binDir, daprRuntimeFilePrefix), path)
+
+		_, err = lookupRuntimeBinary(runtimePath, "1.14.0")
+		require.ErrorContains(t, err, "not installed")
+
+		_, err = lookupRuntimeBinary(runtimePath, "../1.15.0")
+		require.ErrorContains(t, err, "invalid runtime version")
+	})
+
+	t.Run("use keeps the previously active runtime", func(t *testing.T) {
+		require.NoError(t, UseRuntime(runtimePath, "1.15.0"))
+		assert.Equal(t, "1.15.0", activeRuntimeVersion(runtimePath))
+
+		list, err := ListRuntimes(runtimePath)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, "1.16.0", list[0].Version)
+		assert.False(t, list[0].Active)
+		assert.Equal(t, binaryFilePathWithDir(filepath.Join(getDaprRuntimesPath(daprDir), "1.16.0"), daprRuntimeFilePrefix), list[0].Path)
+		assert.True(t, list[1].Active)
+
+		require.ErrorContains(t, UseRuntime(runtimePath, "1.14.0"), "dapr runtime install 1.14.0")
+	})
+
+	t.Run("install keeps an installed version and the active runtime", func(t *testing.T) {
+		version, err := InstallRuntime(runtimePath, "v1.16.0", VerifyStrict)
+		require.NoError(t, err)
+		assert.Equal(t, "1.16.0", version)
+		assert.Equal(t, "1.15.0", activeRuntimeVersion(runtimePath))
+
+		_, err = InstallRuntime(runtimePath, "../1.16.0", VerifyStrict)
+		require.ErrorContains(t, err, "invalid runtime version")
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		require.ErrorContains(t, RemoveRuntime(runtimePath, "1.15.0"), "active runtime")
+		require.NoError(t, RemoveRuntime(runtimePath, "1.16.0"))
+		require.ErrorContains(t, RemoveRuntime(runtimePath, "1.16.0"), "not installed")
+
+		list, err := ListRuntimes(runtimePath)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, "1.15.0", list[0].Version)
+	})
+}
+
+func TestCompareRuntimeVersions(t *testing.T) {
+	assert.Positive(t, compareRuntimeVersions("1.16.0", "1.15.3"))
+	assert.Negative(t, compareRuntimeVersions("1.16.0-rc.1", "1.16.0"))
+	assert.Positive(t, compareRuntimeVersions("edge", "1.0.0"))
+	assert.Zero(t, compareRuntimeVersions("edge", "edge"))
+}
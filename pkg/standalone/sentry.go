@@ -0,0 +1,492 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	path_filepath "path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/dapr/cli/pkg/print"
+	cli_ver "github.com/dapr/cli/pkg/version"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/dapr/pkg/sentry/server/ca/bundle"
+)
+
+const (
+	rootCertFileName   = "ca.crt"
+	issuerCertFileName = "issuer.crt"
+	issuerKeyFileName  = "issuer.key"
+
+	// sentryTrustDomain is the default trust domain of both Sentry and daprd
+	// in self-hosted mode.
+	sentryTrustDomain        = "localhost"
+	sentryAllowedClockSkew   = 15 * time.Minute
+	defaultCertValidity      = 365 * 24 * time.Hour
+	warningDaysForCertExpiry = 30
+
+	sentryPort       = 50001
+	sentryHealthPort = 58082
+	sentryMetricPort = 59092
+
+	sentryCredentialsContainerDir = "/var/run/dapr/credentials"
+
+	// containerHostName resolves to the host in the containers which are not
+	// in a Docker network, so they can reach Sentry on its published port.
+	containerHostName = "host.docker.internal"
+
+	trustAnchorsEnvVar = "DAPR_TRUST_ANCHORS"
+)
+
+// RenewCertificateOptions configures the renewal of the self-hosted
+// certificate chain. When no certificate files are given a new chain is
+// generated, signed with the root key if one is given.
+type RenewCertificateOptions struct {
+	DaprInstallPath           string
+	RootCertificateFilePath   string
+	IssuerCertificateFilePath string
+	IssuerPrivateKeyFilePath  string
+	RootPrivateKeyFilePath    string
+	ValidUntil                time.Duration
+}
+
+// createCertificates generates the root and issuer certificates used by
+// Sentry, unless the certs directory already holds a chain.
+func createCertificates(certsDir string) error {
+	if _, err := os.Stat(path_filepath.Join(certsDir, rootCertFileName)); err == nil {
+		// The certificates are mounted into the placement and scheduler
+		// containers, which do not run as the current user.
+		for _, name := range []string{rootCertFileName, issuerCertFileName} {
+			if err = os.Chmod(path_filepath.Join(certsDir, name), 0o644); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	rootCert, issuerCert, issuerKey, err := generateCertificates(defaultCertValidity, nil)
+	if err != nil {
+		return err
+	}
+	return writeCertificates(certsDir, rootCert, issuerCert, issuerKey)
+}
+
+// generateCertificates generates a root certificate and an issuer certificate
+// and key. A new root key is generated when rootKey is nil.
+func generateCertificates(validUntil time.Duration, rootKey crypto.Signer) ([]byte, []byte, []byte, error) {
+	if rootKey == nil {
+		var err error
+		rootKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	x509Bundle, err := bundle.GenerateX509(bundle.OptionsX509{
+		X509RootKey:      rootKey,
+		TrustDomain:      sentryTrustDomain,
+		AllowedClockSkew: sentryAllowedClockSkew,
+		OverrideCATTL:    &validUntil,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return x509Bundle.TrustAnchors, x509Bundle.IssChainPEM, x509Bundle.IssKeyPEM, nil
+}
+
+func writeCertificates(certsDir string, rootCert, issuerCert, issuerKey []byte) error {
+	if err := os.MkdirAll(certsDir, 0o700); err != nil {
+		return err
+	}
+
+	// Only the key is private. The certificates are mounted into the
+	// placement and scheduler containers.
+	files := []struct {
+		name string
+		b    []byte
+		perm os.FileMode
+	}{
+		{rootCertFileName, rootCert, 0o644},
+		{issuerCertFileName, issuerCert, 0o644},
+		{issuerKeyFileName, issuerKey, 0o600},
+	}
+	for _, f := range files {
+		path := path_filepath.Join(certsDir, f.name)
+		if err := os.WriteFile(path, f.b, f.perm); err != nil {
+			return err
+		}
+		// WriteFile keeps the permissions of a file which exists.
+		if err := os.Chmod(path, f.perm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseRootCertificate parses the first certificate of a PEM file.
+func parseRootCertificate(b []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("root certificate is not pem encoded")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// enableMTLSConfiguration enables mTLS in a configuration file, keeping the
+// rest of the file as it is.
+func enableMTLSConfiguration(filePath string) error {
+	b, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	var config yaml.MapSlice
+	if err = yaml.Unmarshal(b, &config); err != nil {
+		return err
+	}
+
+	config = setConfigValue(config, true, "spec", "mtls", "enabled")
+
+	b, err = yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	// #nosec G306
+	return os.WriteFile(filePath, b, 0o644)
+}
+
+// setConfigValue sets the value at a path of keys, adding the maps which are
+// missing.
+func setConfigValue(m yaml.MapSlice, value interface{}, keys ...string) yaml.MapSlice {
+	for i := range m {
+		if m[i].Key != keys[0] {
+			continue
+		}
+		if len(keys) == 1 {
+			m[i].Value = value
+		} else {
+			child, _ := m[i].Value.(yaml.MapSlice)
+			m[i].Value = setConfigValue(child, value, keys[1:]...)
+		}
+		return m
+	}
+
+	if len(keys) == 1 {
+		return append(m, yaml.MapItem{Key: keys[0], Value: value})
+	}
+	return append(m, yaml.MapItem{Key: keys[0], Value: setConfigValue(nil, value, keys[1:]...)})
+}
+
+// sentryTrustAnchors returns the root certificate of the self-hosted Sentry
+// service, which daprd needs to verify Sentry when mTLS is enabled.
+func sentryTrustAnchors(inputInstallPath string) ([]byte, error) {
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path_filepath.Join(GetDaprCertsPath(daprDir), rootCertFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("mTLS is enabled but no Sentry certificates are found in %s. Run `dapr init --sentry` or set %s", GetDaprCertsPath(daprDir), trustAnchorsEnvVar)
+	}
+	return b, err
+}
+
+// IsMTLSEnabled returns whether mTLS is enabled in the default configuration
+// of a self-hosted installation.
+func IsMTLSEnabled(inputInstallPath string) (bool, error) {
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return false, err
+	}
+
+	configPath := GetDaprConfigPath(daprDir)
+	if _, err = os.Stat(configPath); err != nil {
+		return false, fmt.Errorf("%s not found, please run `dapr init` first", configPath)
+	}
+	return mtlsEndpoint(configPath) != "", nil
+}
+
+// ExportTrustChain copies the root certificate, issuer certificate and issuer
+// key of a self-hosted installation into a directory.
+func ExportTrustChain(inputInstallPath, outputDir string) error {
+	certsDir, err := getCertsDir(inputInstallPath)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(outputDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, name := range []string{rootCertFileName, issuerCertFileName, issuerKeyFileName} {
+		b, err := os.ReadFile(path_filepath.Join(certsDir, name))
+		if err != nil {
+			return err
+		}
+		if err = os.WriteFile(path_filepath.Join(outputDir, name), b, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Expiry returns the expiry time of the self-hosted root certificate.
+func Expiry(inputInstallPath string) (*time.Time, error) {
+	certsDir, err := getCertsDir(inputInstallPath)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path_filepath.Join(certsDir, rootCertFileName))
+	if err != nil {
+		return nil, err
+	}
+	cert, err := parseRootCertificate(b)
+	if err != nil {
+		return nil, err
+	}
+	return &cert.NotAfter, nil
+}
+
+// CheckForCertExpiry warns if the self-hosted root certificate expires in
+// less than `warningDaysForCertExpiry` days.
+func CheckForCertExpiry(inputInstallPath string) {
+	expiry, err := Expiry(inputInstallPath)
+	// Only warn when the expiry can be read, without interrupting the command.
+	if err != nil {
+		return
+	}
+	daysRemaining := int(expiry.Sub(time.Now().UTC()).Hours() / 24)
+	if daysRemaining >= warningDaysForCertExpiry {
+		return
+	}
+
+	var warningMessage string
+	switch {
+	case daysRemaining == 0:
+		warningMessage = "Dapr root certificate of your self-hosted installation expires today."
+	case daysRemaining < 0:
+		warningMessage = "Dapr root certificate of your self-hosted installation has expired."
+	default:
+		warningMessage = fmt.Sprintf("Dapr root certificate of your self-hosted installation expires in %v days.", daysRemaining)
+	}
+	print.WarningStatusEvent(os.Stdout, "%s Expiry date: %s. \n Run `dapr mtls renew-certificate --self-hosted` to renew it.", warningMessage, expiry.Format(time.RFC1123))
+}
+
+// RenewCertificate replaces the self-hosted certificate chain. Sentry picks
+// up the new chain by itself, but running sidecars keep trusting the previous
+// root certificate until they are restarted.
+func RenewCertificate(opts RenewCertificateOptions) error {
+	daprDir, err := GetDaprRuntimePath(opts.DaprInstallPath)
+	if err != nil {
+		return err
+	}
+
+	var rootCert, issuerCert, issuerKey []byte
+	if opts.RootCertificateFilePath != "" {
+		if rootCert, err = os.ReadFile(opts.RootCertificateFilePath); err != nil {
+			return err
+		}
+		if _, err = parseRootCertificate(rootCert); err != nil {
+			return fmt.Errorf("error parsing %s: %w", opts.RootCertificateFilePath, err)
+		}
+		if issuerCert, err = os.ReadFile(opts.IssuerCertificateFilePath); err != nil {
+			return err
+		}
+		if issuerKey, err = os.ReadFile(opts.IssuerPrivateKeyFilePath); err != nil {
+			return err
+		}
+	} else {
+		var rootKey crypto.Signer
+		if opts.RootPrivateKeyFilePath != "" {
+			if rootKey, err = readRootKey(opts.RootPrivateKeyFilePath); err != nil {
+				return err
+			}
+		}
+		if rootCert, issuerCert, issuerKey, err = generateCertificates(opts.ValidUntil, rootKey); err != nil {
+			return err
+		}
+	}
+
+	return writeCertificates(GetDaprCertsPath(daprDir), rootCert, issuerCert, issuerKey)
+}
+
+func readRootKey(path string) (crypto.Signer, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("provided private key file is not pem encoded")
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+func getCertsDir(inputInstallPath string) (string, error) {
+	daprDir, err := GetDaprRuntimePath(inputInstallPath)
+	if err != nil {
+		return "", err
+	}
+
+	certsDir := GetDaprCertsPath(daprDir)
+	if _, err = os.Stat(path_filepath.Join(certsDir, rootCertFileName)); err != nil {
+		return "", fmt.Errorf("no certificates found in %s, please run `dapr init --sentry` first", certsDir)
+	}
+	return certsDir, nil
+}
+
+func installSentry(wg *sync.WaitGroup, errorChan chan<- error, info initInfo) {
+	defer wg.Done()
+
+	if !info.slimMode || !info.sentry {
+		return
+	}
+
+	err := installBinary(info.runtimeVersion, sentryServiceFilePrefix, cli_ver.DaprGitHubRepo, info)
+	if err != nil {
+		errorChan <- err
+	}
+}
+
+func runSentryService(wg *sync.WaitGroup, errorChan chan<- error, info initInfo) {
+	defer wg.Done()
+
+	if info.slimMode || !info.sentry {
+		return
+	}
+
+	runtimeCmd := utils.GetContainerRuntimeCmd(info.containerRuntime)
+	sentryContainerName := utils.CreateContainerName(DaprSentryContainerName, info.dockerNetwork)
+
+	exists, err := confirmContainerIsRunningOrExists(sentryContainerName, false, runtimeCmd)
+	if err != nil {
+		errorChan <- err
+		return
+	} else if exists {
+		errorChan <- fmt.Errorf("%s container exists or is running. %s", sentryContainerName, errInstallTemplate)
+		return
+	}
+
+	var image string
+	if isAirGapInit {
+		// if --from-dir flag is given load the image details from the installer-bundle.
+		dir := path_filepath.Join(info.fromDir, *info.bundleDet.ImageSubDir)
+		image = info.bundleDet.getDaprImageName()
+		err = loadContainer(dir, info.bundleDet.getDaprImageFileName(), info.containerRuntime)
+	} else {
+		image, err = getDaprImageName(daprImageInfo{
+			ghcrImageName:      daprGhcrImageName,
+			dockerHubImageName: daprDockerImageName,
+			imageRegistryURL:   info.imageRegistryURL,
+			imageRegistryName:  defaultImageRegistryName,
+		}, info)
+	}
+	if err != nil {
+		errorChan <- err
+		return
+	}
+
+	args := sentryContainer(info, image).runArgs(info.dockerNetwork)
+
+	_, err = utils.RunCmdAndWait(runtimeCmd, args...)
+	if err != nil {
+		runError := isContainerRunError(err)
+		if !runError {
+			errorChan <- parseContainerRuntimeError("sentry service", err)
+		} else {
+			errorChan <- fmt.Errorf("%s %s failed with: %w", runtimeCmd, args, err)
+		}
+		return
+	}
+	errorChan <- nil
+}
+
+func sentryContainer(info initInfo, image string) containerSpec {
+	spec := containerSpec{
+		name:       DaprSentryContainerName,
+		image:      image,
+		entrypoint: "./sentry",
+		volumes:    []string{GetDaprCertsPath(info.installDir) + ":" + sentryCredentialsContainerDir},
+		ports: []string{
+			fmt.Sprintf("%v:%v", sentryPort, sentryPort),
+			fmt.Sprintf("%v:8080", sentryHealthPort),
+			fmt.Sprintf("%v:9090", sentryMetricPort),
+		},
+		args: []string{
+			"--issuer-credentials", sentryCredentialsContainerDir,
+			"--trust-domain", sentryTrustDomain,
+			"--port", strconv.Itoa(sentryPort),
+		},
+	}
+	if runtime.GOOS != daprWindowsOS {
+		// The certificates are only readable by the current user.
+		spec.user = fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid())
+	}
+	return spec
+}
+
+// controlPlaneMTLSArgs returns the arguments with which placement and
+// scheduler serve with mTLS, using an identity issued by Sentry.
+func controlPlaneMTLSArgs(trustAnchorsFile, sentryAddress string) []string {
+	return []string{
+		"--tls-enabled",
+		"--trust-domain", sentryTrustDomain,
+		"--trust-anchors-file", trustAnchorsFile,
+		"--sentry-address", sentryAddress,
+	}
+}
+
+// withSentry makes a placement or scheduler container serve with mTLS when
+// Sentry is installed, mounting the trust anchors and issuer certificate.
+func (c containerSpec) withSentry(info initInfo) containerSpec {
+	if !info.sentry {
+		return c
+	}
+
+	certsDir := GetDaprCertsPath(info.installDir)
+	for _, name := range []string{rootCertFileName, issuerCertFileName} {
+		c.volumes = append(c.volumes, path_filepath.Join(certsDir, name)+":"+sentryCredentialsContainerDir+"/"+name+":ro")
+	}
+
+	sentryAddress := fmt.Sprintf("%s:%d", DaprSentryContainerName, sentryPort)
+	if info.dockerNetwork == "" {
+		sentryAddress = fmt.Sprintf("%s:%d", containerHostName, sentryPort)
+		c.extraHosts = append(c.extraHosts, containerHostName+":host-gateway")
+	}
+	c.args = append(c.args, controlPlaneMTLSArgs(sentryCredentialsContainerDir+"/"+rootCertFileName, sentryAddress)...)
+	return c
+}
+
+// slimMTLSCommand returns the command to start a placement or scheduler binary
+// with mTLS against a Sentry binary running on the same host.
+func slimMTLSCommand(binary, installDir string) string {
+	args := controlPlaneMTLSArgs(path_filepath.Join(GetDaprCertsPath(installDir), rootCertFileName), fmt.Sprintf("localhost:%d", sentryPort))
+	return strings.Join(append([]string{binary}, args...), " ")
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	path_filepath "path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCertificates(t *testing.T) {
+	certsDir := path_filepath.Join(t.TempDir(), defaultCertsDirName)
+	require.NoError(t, createCertificates(certsDir))
+
+	rootCert, err := os.ReadFile(path_filepath.Join(certsDir, rootCertFileName))
+	require.NoError(t, err)
+	root, err := parseRootCertificate(rootCert)
+	require.NoError(t, err)
+	assert.True(t, root.IsCA)
+
+	issuerCert, err := os.ReadFile(path_filepath.Join(certsDir, issuerCertFileName))
+	require.NoError(t, err)
+	issuer, err := parseRootCertificate(issuerCert)
+	require.NoError(t, err)
+	assert.NoError(t, issuer.CheckSignatureFrom(root))
+
+	t.Run("existing certificates are kept", func(t *testing.T) {
+		require.NoError(t, createCertificates(certsDir))
+		b, err := os.ReadFile(path_filepath.Join(certsDir, rootCertFileName))
+		require.NoError(t, err)
+		assert.Equal(t, rootCert, b)
+	})
+
+	t.Run("only the key is private", func(t *testing.T) {
+		if runtime.GOOS == daprWindowsOS {
+			t.Skip("file modes are not enforced on Windows")
+		}
+		for name, perm := range map[string]os.FileMode{rootCertFileName: 0o644, issuerCertFileName: 0o644, issuerKeyFileName: 0o600} {
+			fi, err := os.Stat(path_filepath.Join(certsDir, name))
+			require.NoError(t, err)
+			assert.Equal(t, perm, fi.Mode().Perm(), name)
+		}
+	})
+}
+
+func TestControlPlaneMTLS(t *testing.T) {
+	installDir := t.TempDir()
+	certsDir := GetDaprCertsPath(installDir)
+	volume := "dapr_scheduler"
+	info := initInfo{
+		runtimeVersion:  "1.16.0",
+		installDir:      installDir,
+		schedulerVolume: &volume,
+		sentry:          true,
+	}
+	volumes := []string{
+		path_filepath.Join(certsDir, rootCertFileName) + ":/var/run/dapr/credentials/ca.crt:ro",
+		path_filepath.Join(certsDir, issuerCertFileName) + ":/var/run/dapr/credentials/issuer.crt:ro",
+	}
+	mtlsArgs := func(sentryAddress string) []string {
+		return []string{
+			"--tls-enabled",
+			"--trust-domain", "localhost",
+			"--trust-anchors-file", "/var/run/dapr/credentials/ca.crt",
+			"--sentry-address", sentryAddress,
+		}
+	}
+
+	for name, spec := range map[string]func(initInfo, string) containerSpec{
+		DaprPlacementContainerName: placementContainer,
+		DaprSchedulerContainerName: schedulerContainer,
+	} {
+		t.Run(name+" without a network", func(t *testing.T) {
+			c := spec(info, "daprio/dapr:1.16.0")
+			assert.Subset(t, c.volumes, volumes)
+			assert.Equal(t, []string{"host.docker.internal:host-gateway"}, c.extraHosts)
+			assert.Equal(t, mtlsArgs("host.docker.internal:50001"), c.args[len(c.args)-7:])
+
+			args := c.runArgs("")
+			assert.Contains(t, args, "--add-host")
+			assert.Contains(t, args, "--tls-enabled")
+		})
+
+		t.Run(name+" in a network", func(t *testing.T) {
+			info := info
+			info.dockerNetwork = "dapr-net"
+			c := spec(info, "daprio/dapr:1.16.0")
+			assert.Subset(t, c.volumes, volumes)
+			assert.Empty(t, c.extraHosts)
+			assert.Equal(t, mtlsArgs("dapr_sentry:50001"), c.args[len(c.args)-7:])
+		})
+
+		t.Run(name+" without sentry", func(t *testing.T) {
+			info := info
+			info.sentry = false
+			c := spec(info, "daprio/dapr:1.16.0")
+			assert.NotContains(t, c.args, "--tls-enabled")
+			assert.Empty(t, c.extraHosts)
+		})
+	}
+
+	t.Run("slim binaries", func(t *testing.T) {
+		assert.Equal(t,
+			"placement --tls-enabled --trust-domain localhost --trust-anchors-file "+path_filepath.Join(certsDir, rootCertFileName)+" --sentry-address localhost:50001",
+			slimMTLSCommand(placementServiceFilePrefix, installDir))
+		assert.Contains(t, slimMTLSCommand(schedulerServiceFilePrefix, installDir), "scheduler --tls-enabled ")
+	})
+}
+
+func TestEnableMTLSConfiguration(t *testing.T) {
+	configPath := path_filepath.Join(t.TempDir(), DefaultConfigFileName)
+	require.NoError(t, createDefaultConfiguration("localhost", configPath))
+	assert.Empty(t, mtlsEndpoint(configPath))
+
+	require.NoError(t, enableMTLSConfiguration(configPath))
+	assert.Equal(t, sentryDefaultAddress, mtlsEndpoint(configPath))
+
+	b, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "endpointAddress: http://localhost:9411/api/v2/spans")
+}
+
+func TestSelfHostedTrustChain(t *testing.T) {
+	installPath := t.TempDir()
+	daprDir, err := GetDaprRuntimePath(installPath)
+	require.NoError(t, err)
+
+	t.Run("not initialized", func(t *testing.T) {
+		_, err := Expiry(installPath)
+		require.Error(t, err)
+		_, err = sentryTrustAnchors(installPath)
+		assert.ErrorContains(t, err, "dapr init --sentry")
+	})
+
+	require.NoError(t, createCertificates(GetDaprCertsPath(daprDir)))
+
+	t.Run("expiry", func(t *testing.T) {
+		expiry, err := Expiry(installPath)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(defaultCertValidity), *expiry, time.Hour)
+	})
+
+	t.Run("export", func(t *testing.T) {
+		outputDir := path_filepath.Join(t.TempDir(), "certs")
+		require.NoError(t, ExportTrustChain(installPath, outputDir))
+		for _, name := range []string{rootCertFileName, issuerCertFileName, issuerKeyFileName} {
+			assert.FileExists(t, path_filepath.Join(outputDir, name))
+		}
+
+		trustAnchors, err := sentryTrustAnchors(installPath)
+		require.NoError(t, err)
+		exported, err := os.ReadFile(path_filepath.Join(outputDir, rootCertFileName))
+		require.NoError(t, err)
+		assert.Equal(t, trustAnchors, exported)
+	})
+
+	t.Run("renew with root key", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		keyFile := path_filepath.Join(t.TempDir(), "root.key")
+		require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+
+		require.NoError(t, RenewCertificate(RenewCertificateOptions{
+			DaprInstallPath:        installPath,
+			RootPrivateKeyFilePath: keyFile,
+			ValidUntil:             30 * 24 * time.Hour,
+		}))
+
+		expiry, err := Expiry(installPath)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), *expiry, time.Hour)
+
+		trustAnchors, err := sentryTrustAnchors(installPath)
+		require.NoError(t, err)
+		root, err := parseRootCertificate(trustAnchors)
+		require.NoError(t, err)
+		assert.True(t, key.PublicKey.Equal(root.PublicKey))
+	})
+}
@@ -44,6 +44,7 @@ const (
 	daprRuntimeFilePrefix      = "daprd"
 	placementServiceFilePrefix = "placement"
 	schedulerServiceFilePrefix = "scheduler"
+	sentryServiceFilePrefix    = "sentry"
 
 	daprWindowsOS = "windows"
 
@@ -81,6 +82,8 @@ const (
 	DaprRedisContainerName = "dapr_redis"
 	// DaprZipkinContainerName is the container name of zipkin.
 	DaprZipkinContainerName = "dapr_zipkin"
+	// DaprSentryContainerName is the container name of sentry service.
+	DaprSentryContainerName = "dapr_sentry"
 
 	errInstallTemplate = "please run `dapr uninstall` first before running `dapr init`"
 
@@ -111,6 +114,11 @@ type configuration struct {
 			Zipkin       struct {
 				EndpointAddress string `yaml:"endpointAddress,omitempty"`
 			} `yaml:"zipkin,omitempty"`
+			Otel struct {
+				EndpointAddress string `yaml:"endpointAddress,omitempty"`
+				IsSecure        bool   `yaml:"isSecure"`
+				Protocol        string `yaml:"protocol,omitempty"`
+			} `yaml:"otel,omitempty"`
 		} `yaml:"tracing,omitempty"`
 	} `yaml:"spec"`
 }
@@ -146,6 +154,9 @@ type initInfo struct {
 	schedulerVolume                    *string
 	schedulerOverrideBroadcastHostPort *string
 	redisStack                         bool
+	verify                             VerifyMode
+	sentry                             bool
+	addons                             []addon
 }
 
 // InitOptions configures a standalone Dapr initialization.
@@ -161,6 +172,13 @@ type InitOptions struct {
 	SchedulerVolume                    *string
 	SchedulerOverrideBroadcastHostPort *string
 	RedisStack                         bool
+	// Verify is how downloaded and bundled binaries are verified.
+	Verify VerifyMode
+	// Sentry installs the Sentry service with a generated certificate chain
+	// and enables mTLS in the default configuration.
+	Sentry bool
+	// Addons are the names of the add-ons to run next to Redis and Zipkin.
+	Addons []string
 }
 
 type daprImageInfo struct {
@@ -201,45 +219,13 @@ func isSchedulerIncluded(runtimeVersion string) (bool, error) {
 	return c.Check(&vNoPrerelease), nil
 }
 
-// Init installs Dapr on a local machine using the supplied runtimeVersion.
-func Init(opts InitOptions) error {
-	var err error
-	var bundleDet bundleDetails
-	runtimeVersion := opts.RuntimeVersion
-	dockerNetwork := opts.DockerNetwork
-	slimMode := opts.SlimMode
-	imageRegistryURL := opts.ImageRegistryURL
-	fromDir := opts.FromDir
-	containerRuntime := opts.ContainerRuntime
-	imageVariant := opts.ImageVariant
-	daprInstallPath := opts.DaprInstallPath
-	schedulerVolume := opts.SchedulerVolume
-	schedulerOverrideBroadcastHostPort := opts.SchedulerOverrideBroadcastHostPort
-	redisStack := opts.RedisStack
-
-	containerRuntime = strings.TrimSpace(containerRuntime)
-	daprInstallPath = strings.TrimSpace(daprInstallPath)
-	// AirGap init flow is true when fromDir var is set i.e. --from-dir flag has value.
-	fromDir = strings.TrimSpace(fromDir)
-	setAirGapInit(fromDir)
-	if !slimMode {
-		// If --slim installation is not requested, check if docker is installed.
-		containerRuntimeAvailable := utils.IsContainerRuntimeInstalled(containerRuntime)
-		if !containerRuntimeAvailable {
-			return fmt.Errorf("could not connect to %s. %s may not be installed or running", containerRuntime, containerRuntime)
-		}
-
-		// Initialize default registry only if any of --slim or --image-registry or --from-dir are not given.
-		if len(strings.TrimSpace(imageRegistryURL)) == 0 && !isAirGapInit {
-			defaultImageRegistryName, err = utils.GetDefaultRegistry(githubContainerRegistryName, dockerContainerRegistryName)
-			if err != nil {
-				return err
-			}
-		}
+// resolveRuntimeVersion returns the latest release version when the runtime
+// version is latest.
+func resolveRuntimeVersion(runtimeVersion, imageRegistryURL string) (string, error) {
+	if runtimeVersion != latestVersion {
+		return runtimeVersion, nil
 	}
 
-	// Set runtime version.
-
 	// Determine the effective registry URL for version resolution.
 	// When no custom registry is provided, use the default registry
 	// (GHCR or Docker Hub) so we query tags from the same registry
@@ -249,20 +235,55 @@ func Init(opts InitOptions) error {
 		effectiveRegistryURL = ghcrURI
 	}
 
-	if runtimeVersion == latestVersion && !isAirGapInit {
-		runtimeVersion, err = cli_ver.GetLatestVersion(cli_ver.DaprImageRef(effectiveRegistryURL))
+	version, err := cli_ver.GetLatestVersion(cli_ver.DaprImageRef(effectiveRegistryURL))
+	if err != nil {
+		return "", fmt.Errorf("cannot get the latest release version: '%w'. Try specifying --runtime-version=<desired_version>", err)
+	}
+	return version, nil
+}
+
+// newInitInfo resolves the options of an init, such as the runtime version
+// and the default image registry, without changing anything on the machine.
+func newInitInfo(opts InitOptions) (initInfo, error) {
+	var err error
+	var bundleDet bundleDetails
+	runtimeVersion := opts.RuntimeVersion
+	slimMode := opts.SlimMode
+	imageRegistryURL := opts.ImageRegistryURL
+
+	// AirGap init flow is true when fromDir var is set i.e. --from-dir flag has value.
+	fromDir := strings.TrimSpace(opts.FromDir)
+	setAirGapInit(fromDir)
+
+	addons, err := parseAddons(opts.Addons)
+	if err != nil {
+		return initInfo{}, err
+	}
+	if len(addons) > 0 && (slimMode || isAirGapInit) {
+		return initInfo{}, errors.New("add-ons cannot be installed with --slim or --from-dir")
+	}
+
+	// Initialize default registry only if any of --slim or --image-registry or --from-dir are not given.
+	defaultImageRegistryName = ""
+	if !slimMode && len(strings.TrimSpace(imageRegistryURL)) == 0 && !isAirGapInit {
+		defaultImageRegistryName, err = utils.LookupDefaultRegistry(githubContainerRegistryName, dockerContainerRegistryName)
 		if err != nil {
-			return fmt.Errorf("cannot get the latest release version: '%w'. Try specifying --runtime-version=<desired_version>", err)
+			return initInfo{}, err
 		}
 	}
 
-	// If --from-dir flag is given try parsing the details from the expected details file in the specified directory.
-	if isAirGapInit {
-		bundleDet = bundleDetails{}
+	// Set runtime version.
+	if !isAirGapInit {
+		runtimeVersion, err = resolveRuntimeVersion(runtimeVersion, imageRegistryURL)
+		if err != nil {
+			return initInfo{}, err
+		}
+	} else {
+		// If --from-dir flag is given try parsing the details from the expected details file in the specified directory.
 		detailsFilePath := path_filepath.Join(fromDir, bundleDetailsFileName)
 		err = bundleDet.readAndParseDetails(detailsFilePath)
 		if err != nil {
-			return fmt.Errorf("error parsing details file from bundle location: %w", err)
+			return initInfo{}, fmt.Errorf("error parsing details file from bundle location: %w", err)
 		}
 
 		// Set runtime version from the bundle details parsed.
@@ -274,12 +295,60 @@ func Init(opts InitOptions) error {
 
 	// After this point runtimeVersion will not be latest string but rather actual version.
 
-	print.InfoStatusEvent(os.Stdout, "Installing runtime version %s", runtimeVersion)
+	installDir, err := GetDaprRuntimePath(strings.TrimSpace(opts.DaprInstallPath))
+	if err != nil {
+		return initInfo{}, err
+	}
+
+	return initInfo{
+		// values in bundleDet can be nil if fromDir is empty, so must be used in conjunction with fromDir.
+		bundleDet:                          &bundleDet,
+		fromDir:                            fromDir,
+		installDir:                         installDir,
+		slimMode:                           slimMode,
+		runtimeVersion:                     runtimeVersion,
+		dockerNetwork:                      opts.DockerNetwork,
+		imageRegistryURL:                   imageRegistryURL,
+		containerRuntime:                   strings.TrimSpace(opts.ContainerRuntime),
+		imageVariant:                       opts.ImageVariant,
+		schedulerVolume:                    opts.SchedulerVolume,
+		schedulerOverrideBroadcastHostPort: opts.SchedulerOverrideBroadcastHostPort,
+		redisStack:                         opts.RedisStack,
+		verify:                             opts.Verify,
+		sentry:                             opts.Sentry,
+		addons:                             addons,
+	}, nil
+}
+
+// Init installs Dapr on a local machine using the supplied runtimeVersion.
+func Init(opts InitOptions) error {
+	if !opts.SlimMode {
+		// If --slim installation is not requested, check if docker is installed.
+		containerRuntime := strings.TrimSpace(opts.ContainerRuntime)
+		containerRuntimeAvailable := utils.IsContainerRuntimeInstalled(containerRuntime)
+		if !containerRuntimeAvailable {
+			return fmt.Errorf("could not connect to %s. %s may not be installed or running", containerRuntime, containerRuntime)
+		}
+	}
 
-	installDir, err := GetDaprRuntimePath(daprInstallPath)
+	info, err := newInitInfo(opts)
 	if err != nil {
 		return err
 	}
+	if defaultImageRegistryName != "" {
+		utils.PrintDefaultRegistry(defaultImageRegistryName, githubContainerRegistryName)
+	}
+	installDir := info.installDir
+
+	if isAirGapInit && !info.slimMode {
+		imageFile := path_filepath.Join(info.fromDir, *info.bundleDet.ImageSubDir, info.bundleDet.getDaprImageFileName())
+		if err = verifyBundleFile(imageFile, info.bundleDet.Checksums, opts.Verify); err != nil {
+			return err
+		}
+	}
+
+	print.InfoStatusEvent(os.Stdout, "Installing runtime version %s", info.runtimeVersion)
+
 	daprBinDir := getDaprBinPath(installDir)
 	err = prepareDaprInstallDir(daprBinDir)
 	if err != nil {
@@ -299,10 +368,13 @@ func Init(opts InitOptions) error {
 		installDaprRuntime,
 		installPlacement,
 		installScheduler,
+		installSentry,
 		runPlacementService,
 		runSchedulerService,
+		runSentryService,
 		runRedis,
 		runZipkin,
+		runAddons,
 	}
 
 	// Init other configurations, containers.
@@ -321,21 +393,14 @@ func Init(opts InitOptions) error {
 		return err
 	}
 
-	info := initInfo{
-		// values in bundleDet can be nil if fromDir is empty, so must be used in conjunction with fromDir.
-		bundleDet:                          &bundleDet,
-		fromDir:                            fromDir,
-		installDir:                         installDir,
-		slimMode:                           slimMode,
-		runtimeVersion:                     runtimeVersion,
-		dockerNetwork:                      dockerNetwork,
-		imageRegistryURL:                   imageRegistryURL,
-		containerRuntime:                   containerRuntime,
-		imageVariant:                       imageVariant,
-		schedulerVolume:                    schedulerVolume,
-		schedulerOverrideBroadcastHostPort: schedulerOverrideBroadcastHostPort,
-		redisStack:                         redisStack,
+	// The certificates must exist before the Sentry service starts.
+	if opts.Sentry {
+		err = createCertificates(GetDaprCertsPath(installDir))
+		if err != nil {
+			return fmt.Errorf("error creating certificates: %w", err)
+		}
 	}
+
 	for _, step := range initSteps {
 		// Run init on the configurations and containers.
 		go step(&wg, errorChan, info)
@@ -352,6 +417,13 @@ func Init(opts InitOptions) error {
 		}
 	}
 
+	if opts.Sentry {
+		err = enableMTLSConfiguration(GetDaprConfigPath(installDir))
+		if err != nil {
+			return fmt.Errorf("error enabling mTLS in the default configuration: %w", err)
+		}
+	}
+
 	stopSpinning(print.Success)
 
 	msg = "Downloaded binaries and completed components set up."
@@ -360,10 +432,21 @@ func Init(opts InitOptions) error {
 	}
 	print.SuccessStatusEvent(os.Stdout, "%s", msg)
 	print.InfoStatusEvent(os.Stdout, "%s binary has been installed to %s.", daprRuntimeFilePrefix, daprBinDir)
-	if slimMode {
+	if opts.Sentry {
+		print.InfoStatusEvent(os.Stdout, "mTLS is enabled in %s with the certificates in %s.", GetDaprConfigPath(installDir), GetDaprCertsPath(installDir))
+	}
+	if info.slimMode {
 		// Print info on placement binary only on slim install.
 		print.InfoStatusEvent(os.Stdout, "%s binary has been installed to %s.", placementServiceFilePrefix, daprBinDir)
 		print.InfoStatusEvent(os.Stdout, "%s binary has been installed to %s.", schedulerServiceFilePrefix, daprBinDir)
+		if opts.Sentry {
+			print.InfoStatusEvent(os.Stdout, "%s binary has been installed to %s. Start it with `%s --issuer-credentials %s`.",
+				sentryServiceFilePrefix, daprBinDir, sentryServiceFilePrefix, GetDaprCertsPath(installDir))
+			// mTLS is enabled in the configuration, so sidecars only connect
+			// to placement and scheduler serving with mTLS too.
+			print.InfoStatusEvent(os.Stdout, "Start %s with `%s`.", placementServiceFilePrefix, slimMTLSCommand(placementServiceFilePrefix, installDir))
+			print.InfoStatusEvent(os.Stdout, "Start %s with `%s`.", schedulerServiceFilePrefix, slimMTLSCommand(schedulerServiceFilePrefix, installDir))
+		}
 	} else {
 		runtimeCmd := utils.GetContainerRuntimeCmd(info.containerRuntime)
 		dockerContainerNames := []string{DaprPlacementContainerName, DaprRedisContainerName, DaprZipkinContainerName}
@@ -375,8 +458,14 @@ func Init(opts InitOptions) error {
 		if err == nil && hasScheduler {
 			dockerContainerNames = append(dockerContainerNames, DaprSchedulerContainerName)
 		}
+		if opts.Sentry {
+			dockerContainerNames = append(dockerContainerNames, DaprSentryContainerName)
+		}
+		for _, a := range info.addons {
+			dockerContainerNames = append(dockerContainerNames, a.containerName())
+		}
 		for _, container := range dockerContainerNames {
-			containerName := utils.CreateContainerName(container, dockerNetwork)
+			containerName := utils.CreateContainerName(container, info.dockerNetwork)
 			ok, err := confirmContainerIsRunningOrExists(containerName, true, runtimeCmd)
 			if err != nil {
 				return err
@@ -424,25 +513,7 @@ func runZipkin(wg *sync.WaitGroup, errorChan chan<- error, info initInfo) {
 			return
 		}
 
-		args = append(args,
-			"run",
-			"--name", zipkinContainerName,
-			"--restart", "always",
-			"-d",
-		)
-
-		if info.dockerNetwork != "" {
-			args = append(
-				args,
-				"--network", info.dockerNetwork,
-				"--network-alias", DaprZipkinContainerName)
-		} else {
-			args = append(
-				args,
-				"-p", "9411:9411")
-		}
-
-		args = append(args, imageName)
+		args = zipkinContainer(imageName).runArgs(info.dockerNetwork)
 	}
 	_, err = utils.RunCmdAndWait(runtimeCmd, args...)
 	if err != nil {
@@ -485,24 +556,7 @@ func runRedis(wg *sync.WaitGroup, errorChan chan<- error, info initInfo) {
 			return
 		}
 
-		args = append(args,
-			"run",
-			"--name", redisContainerName,
-			"--restart", "always",
-			"-d",
-		)
-
-		if info.dockerNetwork != "" {
-			args = append(
-				args,
-				"--network", info.dockerNetwork,
-				"--network-alias", DaprRedisContainerName)
-		} else {
-			args = append(
-				args,
-				"-p", "6379:6379")
-		}
-		args = append(args, imageName)
+		args = redisContainer(imageName).runArgs(info.dockerNetwork)
 	}
 	_, err = utils.RunCmdAndWait(runtimeCmd, args...)
 	if err != nil {
@@ -535,6 +589,40 @@ func redisImageInfo(redisStack bool, imageRegistryURL string, imageRegistryName
 	}
 }
 
+func zipkinContainer(image string) containerSpec {
+	return containerSpec{
+		name:  DaprZipkinContainerName,
+		image: image,
+		ports: []string{"9411:9411"},
+	}
+}
+
+func redisContainer(image string) containerSpec {
+	return containerSpec{
+		name:  DaprRedisContainerName,
+		image: image,
+		ports: []string{"6379:6379"},
+	}
+}
+
+func placementContainer(info initInfo, image string) containerSpec {
+	osPort := 50005
+	if runtime.GOOS == daprWindowsOS {
+		osPort = 6050
+	}
+
+	return containerSpec{
+		name:       DaprPlacementContainerName,
+		image:      image,
+		entrypoint: "./placement",
+		ports: []string{
+			fmt.Sprintf("%v:50005", osPort),
+			fmt.Sprintf("%v:8080", healthPort),
+			fmt.Sprintf("%v:9090", metricPort),
+		},
+	}.withSentry(info)
+}
+
 func runPlacementService(wg *sync.WaitGroup, errorChan chan<- error, info initInfo) {
 	defer wg.Done()
 
@@ -581,32 +669,7 @@ func runPlacementService(wg *sync.WaitGroup, errorChan chan<- error, info initIn
 		}
 	}
 
-	args := []string{
-		"run",
-		"--name", placementContainerName,
-		"--restart", "always",
-		"-d",
-		"--entrypoint", "./placement",
-	}
-
-	if info.dockerNetwork != "" {
-		args = append(args,
-			"--network", info.dockerNetwork,
-			"--network-alias", DaprPlacementContainerName)
-	} else {
-		osPort := 50005
-		if runtime.GOOS == daprWindowsOS {
-			osPort = 6050
-		}
-
-		args = append(args,
-			"-p", fmt.Sprintf("%v:50005", osPort),
-			"-p", fmt.Sprintf("%v:8080", healthPort),
-			"-p", fmt.Sprintf("%v:9090", metricPort),
-		)
-	}
-
-	args = append(args, image)
+	args := placementContainer(info, image).runArgs(info.dockerNetwork)
 
 	_, err = utils.RunCmdAndWait(runtimeCmd, args...)
 	if err != nil {
@@ -676,65 +739,8 @@ func runSchedulerService(wg *sync.WaitGroup, errorChan chan<- error, info initIn
 		}
 	}
 
-	args := []string{
-		"run",
-		"--name", schedulerContainerName,
-		"--restart", "always",
-		"-d",
-		"--entrypoint", "./scheduler",
-	}
-	if info.schedulerVolume != nil {
-		// Don't touch this file location unless things start breaking.
-		// In Docker, when Docker creates a volume and mounts that volume. Docker
-		// assumes the file permissions of that directory if it exists in the container.
-		// If that directory didn't exist in the container previously, then Docker sets
-		// the permissions owned by root and not writeable.
-		// We are lucky in that the Dapr containers have a world writeable directory at
-		// /var/lock and can therefore mount the Docker volume here.
-		// TODO: update the Dapr scheduler dockerfile to create a scheduler user id writeable
-		// directory at /var/lib/dapr/scheduler, then update the path here.
-		if strings.EqualFold(info.imageVariant, "mariner") {
-			args = append(args, "--volume", *info.schedulerVolume+":/var/tmp")
-		} else {
-			args = append(args, "--volume", *info.schedulerVolume+":/var/lock")
-		}
-	}
-
-	osPort := 50006
-	if info.dockerNetwork != "" {
-		args = append(args,
-			"--network", info.dockerNetwork,
-			"--network-alias", DaprSchedulerContainerName)
-	} else {
-		if runtime.GOOS == daprWindowsOS {
-			osPort = 6060
-		}
-
-		args = append(args,
-			"-p", fmt.Sprintf("%v:50006", osPort),
-			"-p", fmt.Sprintf("%v:2379", schedulerEtcdPort),
-			"-p", fmt.Sprintf("%v:8080", schedulerHealthPort),
-			"-p", fmt.Sprintf("%v:9090", schedulerMetricPort),
-		)
-	}
-
-	if strings.EqualFold(info.imageVariant, "mariner") {
-		args = append(args, image, "--etcd-data-dir=/var/tmp/dapr/scheduler")
-	} else {
-		args = append(args, image, "--etcd-data-dir=/var/lock/dapr/scheduler")
-	}
-
-	if schedulerOverrideHostPort(info) {
-		if info.schedulerOverrideBroadcastHostPort != nil {
-			args = append(args, "--override-broadcast-host-port="+*info.schedulerOverrideBroadcastHostPort)
-		} else {
-			args = append(args, fmt.Sprintf("--override-broadcast-host-port=localhost:%v", osPort))
-		}
-	}
-
-	if schedulerEtcdClientListenAddress(info) {
-		args = append(args, "--etcd-client-listen-address=0.0.0.0")
-	}
+	args := schedulerContainer(info, image).runArgs(info.dockerNetwork)
+	osPort := schedulerHostPort(info)
 
 	// On non-elevated Windows with WSL2 installed, verify the scheduler ports
 	// are free before attempting the container start, but only when the
@@ -802,6 +808,66 @@ func runSchedulerService(wg *sync.WaitGroup, errorChan chan<- error, info initIn
 	errorChan <- nil
 }
 
+// schedulerHostPort returns the port of the host on which the scheduler is
+// reachable.
+func schedulerHostPort(info initInfo) int {
+	if info.dockerNetwork == "" && runtime.GOOS == daprWindowsOS {
+		return 6060
+	}
+	return 50006
+}
+
+func schedulerContainer(info initInfo, image string) containerSpec {
+	spec := containerSpec{
+		name:       DaprSchedulerContainerName,
+		image:      image,
+		entrypoint: "./scheduler",
+	}
+	if info.schedulerVolume != nil {
+		// Don't touch this file location unless things start breaking.
+		// In Docker, when Docker creates a volume and mounts that volume. Docker
+		// assumes the file permissions of that directory if it exists in the container.
+		// If that directory didn't exist in the container previously, then Docker sets
+		// the permissions owned by root and not writeable.
+		// We are lucky in that the Dapr containers have a world writeable directory at
+		// /var/lock and can therefore mount the Docker volume here.
+		// TODO: update the Dapr scheduler dockerfile to create a scheduler user id writeable
+		// directory at /var/lib/dapr/scheduler, then update the path here.
+		if strings.EqualFold(info.imageVariant, "mariner") {
+			spec.volumes = append(spec.volumes, *info.schedulerVolume+":/var/tmp")
+		} else {
+			spec.volumes = append(spec.volumes, *info.schedulerVolume+":/var/lock")
+		}
+	}
+
+	osPort := schedulerHostPort(info)
+	spec.ports = []string{
+		fmt.Sprintf("%v:50006", osPort),
+		fmt.Sprintf("%v:2379", schedulerEtcdPort),
+		fmt.Sprintf("%v:8080", schedulerHealthPort),
+		fmt.Sprintf("%v:9090", schedulerMetricPort),
+	}
+
+	if strings.EqualFold(info.imageVariant, "mariner") {
+		spec.args = append(spec.args, "--etcd-data-dir=/var/tmp/dapr/scheduler")
+	} else {
+		spec.args = append(spec.args, "--etcd-data-dir=/var/lock/dapr/scheduler")
+	}
+
+	if schedulerOverrideHostPort(info) {
+		if info.schedulerOverrideBroadcastHostPort != nil {
+			spec.args = append(spec.args, "--override-broadcast-host-port="+*info.schedulerOverrideBroadcastHostPort)
+		} else {
+			spec.args = append(spec.args, fmt.Sprintf("--override-broadcast-host-port=localhost:%v", osPort))
+		}
+	}
+
+	if schedulerEtcdClientListenAddress(info) {
+		spec.args = append(spec.args, "--etcd-client-listen-address=0.0.0.0")
+	}
+	return spec.withSentry(info)
+}
+
 // checkSchedulerPorts verifies that all ports required by the scheduler
 // service are available. grpcPort is the platform-specific gRPC port
 // (50006 on Linux/Mac, 6060 on Windows).
@@ -856,6 +922,14 @@ func installDaprRuntime(wg *sync.WaitGroup, errorChan chan<- error, info initInf
 	err := installBinary(info.runtimeVersion, daprRuntimeFilePrefix, cli_ver.DaprGitHubRepo, info)
 	if err != nil {
 		errorChan <- err
+		return
+	}
+
+	// Keep a copy in the directory of the version, so other versions can be
+	// installed side by side.
+	err = storeRuntimeVersion(info.installDir, info.runtimeVersion)
+	if err != nil {
+		errorChan <- fmt.Errorf("error storing runtime version %s: %w", info.runtimeVersion, err)
 	}
 }
 
@@ -904,11 +978,19 @@ func installBinary(version, binaryFilePrefix, githubRepo string, info initInfo)
 	dir := getDaprBinPath(info.installDir)
 	if isAirGapInit {
 		filepath = path_filepath.Join(info.fromDir, *info.bundleDet.BinarySubDir, binaryName(binaryFilePrefix))
+		err = verifyBundleFile(filepath, info.bundleDet.Checksums, info.verify)
 	} else {
 		filepath, err = downloadBinary(dir, version, binaryFilePrefix, githubRepo)
 		if err != nil {
 			return fmt.Errorf("error downloading %s binary: %w", binaryFilePrefix, err)
 		}
+		err = verifyDownload(filepath, releaseFileURL(version, binaryFilePrefix, githubRepo), info.verify)
+		if err != nil {
+			os.Remove(filepath)
+		}
+	}
+	if err != nil {
+		return err
 	}
 
 	extractedFilePath, err := extractFile(filepath, dir, binaryFilePrefix)
@@ -967,7 +1049,12 @@ func createComponentsAndConfiguration(wg *sync.WaitGroup, errorChan chan<- error
 		errorChan <- fmt.Errorf("error creating redis statestore component file: %w", err)
 		return
 	}
-	err = createDefaultConfiguration(zipkinHost, configPath)
+	// A tracing add-on replaces Zipkin as the destination of the traces.
+	if otlpHost := addonTracingHost(info.addons, info.dockerNetwork); otlpHost != "" {
+		err = createOTelConfiguration(otlpHost, configPath)
+	} else {
+		err = createDefaultConfiguration(zipkinHost, configPath)
+	}
 	if err != nil {
 		errorChan <- fmt.Errorf("error creating default configuration file: %w", err)
 		return
@@ -1276,23 +1363,40 @@ func createRedisPubSub(redisHost string, componentsPath string) error {
 }
 
 func createDefaultConfiguration(zipkinHost, filePath string) error {
+	defaultConfig := newDefaultConfiguration()
+	if zipkinHost != "" {
+		defaultConfig.Spec.Tracing.SamplingRate = "1"
+		defaultConfig.Spec.Tracing.Zipkin.EndpointAddress = fmt.Sprintf("http://%s:9411/api/v2/spans", zipkinHost) //nolint:nosprintfhostport
+	}
+	return writeConfiguration(defaultConfig, filePath)
+}
+
+// createOTelConfiguration writes the default configuration sending the traces
+// over OTLP gRPC to the given host.
+func createOTelConfiguration(otlpHost, filePath string) error {
+	defaultConfig := newDefaultConfiguration()
+	defaultConfig.Spec.Tracing.SamplingRate = "1"
+	defaultConfig.Spec.Tracing.Otel.EndpointAddress = otlpHost + ":4317"
+	defaultConfig.Spec.Tracing.Otel.Protocol = "grpc"
+	return writeConfiguration(defaultConfig, filePath)
+}
+
+func newDefaultConfiguration() configuration {
 	defaultConfig := configuration{
 		APIVersion: "dapr.io/v1alpha1",
 		Kind:       "Configuration",
 	}
 	defaultConfig.Metadata.Name = "daprConfig"
-	if zipkinHost != "" {
-		defaultConfig.Spec.Tracing.SamplingRate = "1"
-		defaultConfig.Spec.Tracing.Zipkin.EndpointAddress = fmt.Sprintf("http://%s:9411/api/v2/spans", zipkinHost) //nolint:nosprintfhostport
-	}
-	b, err := yaml.Marshal(&defaultConfig)
+	return defaultConfig
+}
+
+func writeConfiguration(config configuration, filePath string) error {
+	b, err := yaml.Marshal(&config)
 	if err != nil {
 		return err
 	}
 
-	err = checkAndOverWriteFile(filePath, b)
-
-	return err
+	return checkAndOverWriteFile(filePath, b)
 }
 
 func checkAndOverWriteFile(filePath string, b []byte) error {
@@ -1330,14 +1434,21 @@ func archiveExt() string {
 }
 
 func downloadBinary(dir, version, binaryFilePrefix, githubRepo string) (string, error) {
-	fileURL := fmt.Sprintf(
+	return downloadFile(dir, releaseFileURL(version, binaryFilePrefix, githubRepo))
+}
+
+// releaseFileURL returns the URL of the release archive of a binary.
+func releaseFileURL(version, binaryFilePrefix, githubRepo string) string {
+	return releaseAssetURL(version, binaryName(binaryFilePrefix), githubRepo)
+}
+
+func releaseAssetURL(version, fileName, githubRepo string) string {
+	return fmt.Sprintf(
 		"https://github.com/%s/%s/releases/download/v%s/%s",
 		cli_ver.DaprGitHubOrg,
 		githubRepo,
 		version,
-		binaryName(binaryFilePrefix))
-
-	return downloadFile(dir, fileURL)
+		fileName)
 }
 
 func binaryName(binaryFilePrefix string) string {
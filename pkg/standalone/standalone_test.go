@@ -45,6 +45,26 @@ spec:
 		assert.Equal(t, expectConfigZipkin, string(content))
 	})
 
+	t.Run("Standalone config OTLP", func(t *testing.T) {
+		expectConfigOTLP := `apiVersion: dapr.io/v1alpha1
+kind: Configuration
+metadata:
+  name: daprConfig
+spec:
+  tracing:
+    samplingRate: "1"
+    otel:
+      endpointAddress: dapr_jaeger:4317
+      isSecure: false
+      protocol: grpc
+`
+		os.Remove(testFile)
+		createOTelConfiguration("dapr_jaeger", testFile)
+		content, err := os.ReadFile(testFile)
+		assert.NoError(t, err)
+		assert.Equal(t, expectConfigOTLP, string(content))
+	})
+
 	t.Run("Standalone config slim", func(t *testing.T) {
 		expectConfigSlim := `apiVersion: dapr.io/v1alpha1
 kind: Configuration
@@ -36,9 +36,16 @@ func removeContainers(uninstallPlacementContainer, uninstallSchedulerContainer,
 		containerErrs = removeDockerContainer(containerErrs, DaprSchedulerContainerName, dockerNetwork, runtimeCmd)
 	}
 
+	// The sentry container is optional, so it is only removed when it exists.
+	sentryContainerName := utils.CreateContainerName(DaprSentryContainerName, dockerNetwork)
+	if exists, _ := confirmContainerIsRunningOrExists(sentryContainerName, false, runtimeCmd); exists {
+		containerErrs = removeDockerContainer(containerErrs, DaprSentryContainerName, dockerNetwork, runtimeCmd)
+	}
+
 	if uninstallAll {
 		containerErrs = removeDockerContainer(containerErrs, DaprRedisContainerName, dockerNetwork, runtimeCmd)
 		containerErrs = removeDockerContainer(containerErrs, DaprZipkinContainerName, dockerNetwork, runtimeCmd)
+		containerErrs = removeAddonContainers(containerErrs, dockerNetwork, runtimeCmd)
 	}
 
 	return containerErrs
@@ -123,7 +130,7 @@ func Uninstall(uninstallAll bool, dockerNetwork string, containerRuntime string,
 	}
 	// We don't delete .dapr/scheduler by choice since it holds state.
 	// To delete .dapr/scheduler, user is expected to use the `--all` flag as it deletes the .dapr folder.
-	// The same happens for .dapr/components folder.
+	// The same happens for .dapr/components and .dapr/certs folders.
 
 	containerRuntime = strings.TrimSpace(containerRuntime)
 	runtimeCmd := utils.GetContainerRuntimeCmd(containerRuntime)
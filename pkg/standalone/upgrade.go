@@ -0,0 +1,557 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	path_filepath "path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/dapr/cli/pkg/print"
+	cli_ver "github.com/dapr/cli/pkg/version"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	// upgradeStagingDirName is the directory in the bin directory the new
+	// binaries are downloaded to before they replace the old ones.
+	upgradeStagingDirName = ".upgrade"
+	// upgradeBackupSuffix is appended to the names of the old binaries and
+	// containers until the upgrade has succeeded.
+	upgradeBackupSuffix = "_previous"
+
+	defaultSchedulerVolume = "dapr_scheduler"
+)
+
+// UpgradeOptions configures a standalone Dapr upgrade.
+type UpgradeOptions struct {
+	RuntimeVersion   string
+	DockerNetwork    string
+	ContainerRuntime string
+	DaprInstallPath  string
+	// ImageRegistryURL and ImageVariant default to those of the running
+	// placement container.
+	ImageRegistryURL string
+	ImageVariant     string
+	// Verify is how the downloaded binaries are verified.
+	Verify VerifyMode
+}
+
+// containerSettings are the settings of a control plane container which are
+// carried over to the container of the new version.
+type containerSettings struct {
+	registryURL       string
+	registryName      string
+	imageVariant      string
+	volume            *string
+	broadcastHostPort *string
+	// network is the Docker network the container is attached to, empty
+	// for the default network.
+	network string
+}
+
+type containerInspect struct {
+	Args   []string `json:"Args"`
+	Config struct {
+		Image string `json:"Image"`
+	} `json:"Config"`
+	Mounts []struct {
+		Type        string `json:"Type"`
+		Name        string `json:"Name"`
+		Source      string `json:"Source"`
+		Destination string `json:"Destination"`
+	} `json:"Mounts"`
+	NetworkSettings struct {
+		Networks map[string]struct{} `json:"Networks"`
+	} `json:"NetworkSettings"`
+}
+
+// defaultNetworks are the networks containers are attached to when Dapr is
+// initialized without --network.
+var defaultNetworks = []string{"bridge", "host", "none", "podman"}
+
+// Upgrade replaces the binaries and control plane containers of a standalone
+// installation with those of another runtime version. Components and
+// configuration are left untouched. If any step fails, the binaries and
+// containers of the previous version are restored. It returns the version
+// upgraded to, which is the latest release when the version is latest.
+func Upgrade(opts UpgradeOptions) (version string, err error) {
+	setAirGapInit("")
+
+	installDir, err := GetDaprRuntimePath(strings.TrimSpace(opts.DaprInstallPath))
+	if err != nil {
+		return "", err
+	}
+	daprBinDir := getDaprBinPath(installDir)
+
+	if _, err = os.Stat(binaryFilePathWithDir(daprBinDir, daprRuntimeFilePrefix)); err != nil {
+		return "", fmt.Errorf("dapr is not installed in %s, please run `dapr init` first", installDir)
+	}
+
+	// Slim installations run placement and scheduler from binaries.
+	_, err = os.Stat(binaryFilePathWithDir(daprBinDir, placementServiceFilePrefix))
+	slimMode := err == nil
+
+	containerRuntime := strings.TrimSpace(opts.ContainerRuntime)
+	runtimeCmd := utils.GetContainerRuntimeCmd(containerRuntime)
+	dockerNetwork := strings.TrimSpace(opts.DockerNetwork)
+
+	var placement, scheduler, sentry *containerSettings
+	if !slimMode {
+		if !utils.IsContainerRuntimeInstalled(containerRuntime) {
+			return "", fmt.Errorf("could not connect to %s. %s may not be installed or running", containerRuntime, containerRuntime)
+		}
+
+		placement, err = findPlacementContainer(runtimeCmd, dockerNetwork)
+		if err != nil {
+			return "", err
+		}
+		// The containers are recreated in the network of the current ones.
+		dockerNetwork = placement.network
+
+		scheduler, err = inspectContainer(runtimeCmd, utils.CreateContainerName(DaprSchedulerContainerName, dockerNetwork))
+		if err != nil {
+			return "", err
+		}
+
+		sentry, err = inspectContainer(runtimeCmd, utils.CreateContainerName(DaprSentryContainerName, dockerNetwork))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	info := initInfo{
+		installDir:       installDir,
+		slimMode:         slimMode,
+		runtimeVersion:   opts.RuntimeVersion,
+		dockerNetwork:    dockerNetwork,
+		imageRegistryURL: opts.ImageRegistryURL,
+		containerRuntime: containerRuntime,
+		imageVariant:     opts.ImageVariant,
+		sentry:           sentry != nil,
+	}
+	defaultImageRegistryName = ""
+	if placement != nil {
+		if info.imageRegistryURL == "" {
+			info.imageRegistryURL = placement.registryURL
+			defaultImageRegistryName = placement.registryName
+		}
+		if info.imageVariant == "" {
+			info.imageVariant = placement.imageVariant
+		}
+	}
+	if !slimMode && info.imageRegistryURL == "" && defaultImageRegistryName == "" {
+		defaultImageRegistryName = dockerContainerRegistryName
+	}
+
+	info.runtimeVersion, err = resolveRuntimeVersion(info.runtimeVersion, info.imageRegistryURL)
+	if err != nil {
+		return "", err
+	}
+
+	hasScheduler, err := isSchedulerIncluded(info.runtimeVersion)
+	if err != nil {
+		return "", err
+	}
+
+	print.InfoStatusEvent(os.Stdout, "Upgrading to runtime version %s", info.runtimeVersion)
+
+	var undo []func() error
+	defer func() {
+		if err == nil {
+			return
+		}
+		print.WarningStatusEvent(os.Stdout, "Upgrade failed, restoring the previous installation.")
+		rollback(undo)
+	}()
+
+	binaries := []string{daprRuntimeFilePrefix}
+	if slimMode {
+		binaries = append(binaries, placementServiceFilePrefix)
+		if hasScheduler {
+			binaries = append(binaries, schedulerServiceFilePrefix)
+		}
+		if _, serr := os.Stat(binaryFilePathWithDir(daprBinDir, sentryServiceFilePrefix)); serr == nil {
+			binaries = append(binaries, sentryServiceFilePrefix)
+		}
+	}
+
+	stagingDir := path_filepath.Join(daprBinDir, upgradeStagingDirName)
+	if err = os.RemoveAll(stagingDir); err != nil {
+		return "", err
+	}
+	if err = prepareDaprInstallDir(stagingDir); err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	stopSpinning := print.Spinner(os.Stdout, "Downloading binaries...")
+	defer stopSpinning(print.Failure)
+
+	staged := make(map[string]string, len(binaries))
+	for _, binary := range binaries {
+		staged[binary], err = downloadStaged(stagingDir, info.runtimeVersion, binary, opts.Verify)
+		if err != nil {
+			return "", err
+		}
+	}
+	stopSpinning(print.Success)
+
+	// Keep the current runtime, so it can still be selected with `dapr run
+	// --runtime-version` or switched back to with `dapr runtime use`.
+	if active := activeRuntimeVersion(opts.DaprInstallPath); active != "" {
+		if serr := storeRuntimeVersion(installDir, active); serr != nil {
+			print.WarningStatusEvent(os.Stdout, "WARNING: could not keep runtime version %s: %s", active, serr)
+		}
+	}
+
+	var backups []string
+	for _, binary := range binaries {
+		backup, err := replaceBinary(daprBinDir, binary, staged[binary], &undo)
+		if err != nil {
+			return "", fmt.Errorf("error replacing %s binary: %w", binary, err)
+		}
+		if backup != "" {
+			backups = append(backups, backup)
+		}
+	}
+
+	var backupContainers []string
+	if !slimMode {
+		info.schedulerVolume = ptr.Of(defaultSchedulerVolume)
+		if scheduler != nil {
+			info.schedulerVolume = scheduler.volume
+			info.schedulerOverrideBroadcastHostPort = scheduler.broadcastHostPort
+		}
+
+		steps := []struct {
+			name    string
+			run     func(*sync.WaitGroup, chan<- error, initInfo)
+			existed bool
+		}{
+			{DaprPlacementContainerName, runPlacementService, true},
+			{DaprSchedulerContainerName, runSchedulerService, scheduler != nil},
+			{DaprSentryContainerName, runSentryService, sentry != nil},
+		}
+		for _, step := range steps {
+			if step.name == DaprSchedulerContainerName && !hasScheduler && !step.existed {
+				continue
+			}
+			// The sentry container is optional and only upgraded when it exists.
+			if step.name == DaprSentryContainerName && !step.existed {
+				continue
+			}
+
+			containerName := utils.CreateContainerName(step.name, info.dockerNetwork)
+			if step.existed {
+				if err = backupContainer(runtimeCmd, containerName, &undo); err != nil {
+					return "", err
+				}
+				backupContainers = append(backupContainers, containerName+upgradeBackupSuffix)
+			}
+
+			undo = append(undo, removeContainer(runtimeCmd, containerName))
+
+			if err = runInitStep(step.run, info); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if serr := storeRuntimeVersion(installDir, info.runtimeVersion); serr != nil {
+		print.WarningStatusEvent(os.Stdout, "WARNING: could not store runtime version %s: %s", info.runtimeVersion, serr)
+	}
+
+	// The upgrade succeeded, so the backups of the previous version are no
+	// longer needed.
+	for _, backup := range backups {
+		if rerr := os.Remove(backup); rerr != nil {
+			print.WarningStatusEvent(os.Stdout, "WARNING: could not remove %s: %s", backup, rerr)
+		}
+	}
+	for _, container := range backupContainers {
+		if _, rerr := utils.RunCmdAndWait(runtimeCmd, "rm", "--force", container); rerr != nil {
+			print.WarningStatusEvent(os.Stdout, "WARNING: could not remove container %s: %s", container, rerr)
+		}
+	}
+
+	return info.runtimeVersion, nil
+}
+
+// findPlacementContainer returns the settings of the placement container.
+// Without a network, the only placement container is looked up and its
+// network is returned with the settings.
+func findPlacementContainer(runtimeCmd, network string) (*containerSettings, error) {
+	name := utils.CreateContainerName(DaprPlacementContainerName, network)
+	if network == "" {
+		out, err := utils.RunCmdAndWait(runtimeCmd, "ps", "--all", "--filter", "name="+DaprPlacementContainerName, "--format", "{{.Names}}")
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the %s containers: %w", DaprPlacementContainerName, err)
+		}
+		names := placementContainerNames(out)
+		if len(names) > 1 {
+			return nil, fmt.Errorf("found the placement containers %s, use --network to select the installation to upgrade", strings.Join(names, ", "))
+		}
+		if len(names) == 1 {
+			name = names[0]
+		}
+	}
+
+	placement, err := inspectContainer(runtimeCmd, name)
+	if err != nil {
+		return nil, err
+	}
+	if placement == nil {
+		return nil, fmt.Errorf("%s container not found, please run `dapr init` first", name)
+	}
+	if utils.CreateContainerName(DaprPlacementContainerName, placement.network) != name {
+		return nil, fmt.Errorf("%s container is attached to network %q instead of the one in its name, use --network to select the installation to upgrade", name, placement.network)
+	}
+
+	return placement, nil
+}
+
+// placementContainerNames returns the names of the placement containers in
+// the output of 'docker ps', leaving out backups of interrupted upgrades.
+func placementContainerNames(out string) []string {
+	var names []string
+	for _, name := range strings.Fields(out) {
+		if strings.HasSuffix(name, upgradeBackupSuffix) {
+			continue
+		}
+		if name == DaprPlacementContainerName || strings.HasPrefix(name, DaprPlacementContainerName+"_") {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// inspectContainer returns the settings of a container, or nil if it does
+// not exist.
+func inspectContainer(runtimeCmd, containerName string) (*containerSettings, error) {
+	exists, err := confirmContainerIsRunningOrExists(containerName, false, runtimeCmd)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	out, err := utils.RunCmdAndWait(runtimeCmd, "inspect", "--type", "container", containerName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to inspect %s container: %w", containerName, err)
+	}
+
+	return parseContainerInspect(out)
+}
+
+func parseContainerInspect(out string) (*containerSettings, error) {
+	var inspected []containerInspect
+	if err := json.Unmarshal([]byte(out), &inspected); err != nil {
+		return nil, fmt.Errorf("unable to parse container details: %w", err)
+	}
+	if len(inspected) != 1 {
+		return nil, fmt.Errorf("expected the details of one container, got %d", len(inspected))
+	}
+	c := inspected[0]
+
+	var settings containerSettings
+	var err error
+	settings.registryURL, settings.registryName, settings.imageVariant, err = parseDaprImage(c.Config.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range c.Mounts {
+		if m.Destination != "/var/lock" && m.Destination != "/var/tmp" {
+			continue
+		}
+		if m.Type == "volume" {
+			settings.volume = ptr.Of(m.Name)
+		} else {
+			settings.volume = ptr.Of(m.Source)
+		}
+	}
+
+	for _, arg := range c.Args {
+		if v, ok := strings.CutPrefix(arg, "--override-broadcast-host-port="); ok {
+			settings.broadcastHostPort = ptr.Of(v)
+		}
+	}
+
+	networks := make([]string, 0, len(c.NetworkSettings.Networks))
+	for network := range c.NetworkSettings.Networks {
+		if !slices.Contains(defaultNetworks, network) {
+			networks = append(networks, network)
+		}
+	}
+	if len(networks) > 1 {
+		slices.Sort(networks)
+		return nil, fmt.Errorf("container is attached to several networks: %s", strings.Join(networks, ", "))
+	}
+	if len(networks) == 1 {
+		settings.network = networks[0]
+	}
+
+	return &settings, nil
+}
+
+// parseDaprImage returns the custom registry, or the default registry name,
+// and the image variant of a Dapr image reference.
+func parseDaprImage(image string) (string, string, string, error) {
+	name, tag := image, ""
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		name, tag = image[:i], image[i+1:]
+	}
+
+	var variant string
+	if strings.HasSuffix(tag, "-mariner") {
+		variant = "mariner"
+	}
+
+	switch {
+	case name == cli_ver.DaprDefaultImage || name == daprDockerImageName:
+		return "", dockerContainerRegistryName, variant, nil
+	case name == ghcrURI+"/"+daprGhcrImageName:
+		return "", githubContainerRegistryName, variant, nil
+	case strings.HasSuffix(name, "/"+daprGhcrImageName):
+		return strings.TrimSuffix(name, "/"+daprGhcrImageName), "", variant, nil
+	default:
+		return "", "", "", fmt.Errorf("unable to determine the registry of image %q, please specify --image-registry", image)
+	}
+}
+
+// downloadStaged downloads and extracts a binary into the staging directory
+// and returns the path of the extracted binary.
+func downloadStaged(stagingDir, version, binaryFilePrefix string, verify VerifyMode) (string, error) {
+	archive, err := downloadBinary(stagingDir, version, binaryFilePrefix, cli_ver.DaprGitHubRepo)
+	if err != nil {
+		return "", fmt.Errorf("error downloading %s binary: %w", binaryFilePrefix, err)
+	}
+	if err = verifyDownload(archive, releaseFileURL(version, binaryFilePrefix, cli_ver.DaprGitHubRepo), verify); err != nil {
+		return "", err
+	}
+
+	extracted, err := extractFile(archive, stagingDir, binaryFilePrefix)
+	if err != nil {
+		return "", err
+	}
+	if extracted == "" {
+		return "", fmt.Errorf("%s binary not found in %s", binaryFilePrefix, path_filepath.Base(archive))
+	}
+
+	return extracted, nil
+}
+
+// replaceBinary moves the staged binary in place of the installed one, which
+// is kept as a backup. It returns the backup path, which is empty if the
+// binary was not installed before.
+func replaceBinary(binDir, binaryFilePrefix, staged string, undo *[]func() error) (string, error) {
+	binaryPath := binaryFilePathWithDir(binDir, binaryFilePrefix)
+	backup := binaryPath + upgradeBackupSuffix
+
+	if _, err := os.Stat(binaryPath); err == nil {
+		if err = os.Rename(binaryPath, backup); err != nil {
+			return "", err
+		}
+		*undo = append(*undo, func() error {
+			return os.Rename(backup, binaryPath)
+		})
+	} else {
+		backup = ""
+	}
+
+	if err := os.Rename(staged, binaryPath); err != nil {
+		return "", err
+	}
+	*undo = append(*undo, func() error {
+		return os.Remove(binaryPath)
+	})
+
+	return backup, makeExecutable(binaryPath)
+}
+
+// backupContainer stops a container and renames it out of the way of its
+// replacement. Undoing it restores and restarts the container.
+func backupContainer(runtimeCmd, containerName string, undo *[]func() error) error {
+	backup := containerName + upgradeBackupSuffix
+
+	// Remove a leftover of an interrupted upgrade.
+	if exists, _ := confirmContainerIsRunningOrExists(backup, false, runtimeCmd); exists {
+		if _, err := utils.RunCmdAndWait(runtimeCmd, "rm", "--force", backup); err != nil {
+			return fmt.Errorf("could not remove container %s: %w", backup, err)
+		}
+	}
+
+	if _, err := utils.RunCmdAndWait(runtimeCmd, "stop", containerName); err != nil {
+		return fmt.Errorf("could not stop container %s: %w", containerName, err)
+	}
+	if _, err := utils.RunCmdAndWait(runtimeCmd, "rename", containerName, backup); err != nil {
+		utils.RunCmdAndWait(runtimeCmd, "start", containerName)
+		return fmt.Errorf("could not rename container %s: %w", containerName, err)
+	}
+
+	*undo = append(*undo, func() error {
+		if _, err := utils.RunCmdAndWait(runtimeCmd, "rename", backup, containerName); err != nil {
+			return err
+		}
+		_, err := utils.RunCmdAndWait(runtimeCmd, "start", containerName)
+		return err
+	})
+
+	return nil
+}
+
+// removeContainer returns an undo step removing a container created by the
+// upgrade, if it exists.
+func removeContainer(runtimeCmd, containerName string) func() error {
+	return func() error {
+		exists, _ := confirmContainerIsRunningOrExists(containerName, false, runtimeCmd)
+		if !exists {
+			return nil
+		}
+		_, err := utils.RunCmdAndWait(runtimeCmd, "rm", "--force", containerName)
+		return err
+	}
+}
+
+// rollback runs the undo steps in reverse order. A failing step is reported
+// and does not stop the remaining ones.
+func rollback(undo []func() error) {
+	for i := len(undo) - 1; i >= 0; i-- {
+		if err := undo[i](); err != nil {
+			print.WarningStatusEvent(os.Stdout, "WARNING: failed to restore the previous installation: %s", err)
+		}
+	}
+}
+
+// runInitStep runs a single step of Init synchronously.
+func runInitStep(step func(*sync.WaitGroup, chan<- error, initInfo), info initInfo) error {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	errorChan := make(chan error, 1)
+	step(&wg, errorChan, info)
+
+	select {
+	case err := <-errorChan:
+		return err
+	default:
+		return nil
+	}
+}
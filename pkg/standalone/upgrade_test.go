@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/ptr"
+)
+
+func TestParseDaprImage(t *testing.T) {
+	tests := []struct {
+		image        string
+		registryURL  string
+		registryName string
+		variant      string
+	}{
+		{"daprio/dapr:1.15.0", "", dockerContainerRegistryName, ""},
+		{"docker.io/daprio/dapr:1.15.0-mariner", "", dockerContainerRegistryName, "mariner"},
+		{"ghcr.io/dapr/dapr:1.15.0", "", githubContainerRegistryName, ""},
+		{"localhost:5000/dapr/dapr:1.15.0-mariner", "localhost:5000", "", "mariner"},
+		{"registry.example.com/mirror/dapr/dapr", "registry.example.com/mirror", "", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.image, func(t *testing.T) {
+			registryURL, registryName, variant, err := parseDaprImage(tc.image)
+			require.NoError(t, err)
+			assert.Equal(t, tc.registryURL, registryURL)
+			assert.Equal(t, tc.registryName, registryName)
+			assert.Equal(t, tc.variant, variant)
+		})
+	}
+
+	_, _, _, err := parseDaprImage("example.com/placement:1.0")
+	require.ErrorContains(t, err, "--image-registry")
+}
+
+func TestParseContainerInspect(t *testing.T) {
+	t.Run("scheduler with volume and broadcast address", func(t *testing.T) {
+		out := `[{
+			"Args": ["--etcd-data-dir=/var/lock/dapr/scheduler", "--override-broadcast-host-port=192.168.1.10:50006"],
+			"Config": {"Image": "ghcr.io/dapr/dapr:1.15.0"},
+			"Mounts": [{"Type": "volume", "Name": "my_scheduler", "Source": "/var/lib/docker/volumes/my_scheduler/_data", "Destination": "/var/lock"}]
+		}]`
+
+		settings, err := parseContainerInspect(out)
+		require.NoError(t, err)
+		assert.Equal(t, &containerSettings{
+			registryName:      githubContainerRegistryName,
+			volume:            ptr.Of("my_scheduler"),
+			broadcastHostPort: ptr.Of("192.168.1.10:50006"),
+		}, settings)
+	})
+
+	t.Run("bind mount", func(t *testing.T) {
+		out := `[{
+			"Config": {"Image": "daprio/dapr:1.15.0-mariner"},
+			"Mounts": [{"Type": "bind", "Source": "/data/scheduler", "Destination": "/var/tmp"}]
+		}]`
+
+		settings, err := parseContainerInspect(out)
+		require.NoError(t, err)
+		assert.Equal(t, "mariner", settings.imageVariant)
+		assert.Equal(t, ptr.Of("/data/scheduler"), settings.volume)
+		assert.Nil(t, settings.broadcastHostPort)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		_, err := parseContainerInspect(`[]`)
+		require.Error(t, err)
+		_, err = parseContainerInspect(`not json`)
+		require.Error(t, err)
+	})
+}
+
+func TestParseContainerInspectNetwork(t *testing.T) {
+	settings, err := parseContainerInspect(`[{
+		"Config": {"Image": "daprio/dapr:1.15.0"},
+		"NetworkSettings": {"Networks": {"mynet": {"IPAddress": "172.18.0.2"}}}
+	}]`)
+	require.NoError(t, err)
+	assert.Equal(t, "mynet", settings.network)
+
+	settings, err = parseContainerInspect(`[{
+		"Config": {"Image": "daprio/dapr:1.15.0"},
+		"NetworkSettings": {"Networks": {"bridge": {}}}
+	}]`)
+	require.NoError(t, err)
+	assert.Empty(t, settings.network)
+
+	_, err = parseContainerInspect(`[{
+		"Config": {"Image": "daprio/dapr:1.15.0"},
+		"NetworkSettings": {"Networks": {"a": {}, "b": {}}}
+	}]`)
+	require.ErrorContains(t, err, "a, b")
+}
+
+func TestPlacementContainerNames(t *testing.T) {
+	assert.Equal(t, []string{"dapr_placement_mynet"}, placementContainerNames("dapr_placement_mynet\ndapr_placement_mynet_previous\nmy_dapr_placement\n"))
+	assert.Equal(t, []string{"dapr_placement", "dapr_placement_mynet"}, placementContainerNames("dapr_placement\ndapr_placement_mynet\n"))
+	assert.Empty(t, placementContainerNames(""))
+}
+
+func TestUpgradeRollback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as container runtime")
+	}
+
+	t.Run("binaries", func(t *testing.T) {
+		binDir := t.TempDir()
+		daprd := binaryFilePathWithDir(binDir, daprRuntimeFilePrefix)
+		placement := binaryFilePathWithDir(binDir, placementServiceFilePrefix)
+		require.NoError(t, os.WriteFile(daprd, []byte("old"), 0o755))
+
+		stage := func(name string) string {
+			staged := filepath.Join(t.TempDir(), name)
+			require.NoError(t, os.WriteFile(staged, []byte("new"), 0o755))
+			return staged
+		}
+
+		var undo []func() error
+		backup, err := replaceBinary(binDir, daprRuntimeFilePrefix, stage("daprd"), &undo)
+		require.NoError(t, err)
+		assert.Equal(t, daprd+upgradeBackupSuffix, backup)
+		// The placement binary was not installed before.
+		backup, err = replaceBinary(binDir, placementServiceFilePrefix, stage("placement"), &undo)
+		require.NoError(t, err)
+		assert.Empty(t, backup)
+
+		b, err := os.ReadFile(daprd)
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(b))
+
+		rollback(undo)
+
+		b, err = os.ReadFile(daprd)
+		require.NoError(t, err)
+		assert.Equal(t, "old", string(b))
+		assert.NoFileExists(t, daprd+upgradeBackupSuffix)
+		assert.NoFileExists(t, placement)
+	})
+
+	t.Run("containers", func(t *testing.T) {
+		dir := t.TempDir()
+		log := filepath.Join(dir, "calls.log")
+		runtimeCmd := filepath.Join(dir, "docker")
+		// Every container exists, except backups of an interrupted upgrade.
+		script := "#!/bin/sh\necho \"$@\" >> " + log + "\n" +
+			"if [ \"$1\" = ps ]; then\n  case \"$4\" in\n    name=*_previous) ;;\n    name=*) echo \"${4#name=}\" ;;\n  esac\nfi\n"
+		require.NoError(t, os.WriteFile(runtimeCmd, []byte(script), 0o755))
+
+		var undo []func() error
+		require.NoError(t, backupContainer(runtimeCmd, "dapr_placement", &undo))
+		undo = append(undo, removeContainer(runtimeCmd, "dapr_placement"))
+
+		rollback(undo)
+
+		b, err := os.ReadFile(log)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"ps --all --filter name=dapr_placement_previous --format {{.Names}}",
+			"stop dapr_placement",
+			"rename dapr_placement dapr_placement_previous",
+			// The new container is removed and the previous one restored.
+			"ps --all --filter name=dapr_placement --format {{.Names}}",
+			"rm --force dapr_placement",
+			"rename dapr_placement_previous dapr_placement",
+			"start dapr_placement",
+		}, strings.Split(strings.TrimSpace(string(b)), "\n"))
+	})
+}
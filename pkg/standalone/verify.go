@@ -0,0 +1,250 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	path_filepath "path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dapr/cli/pkg/print"
+	"github.com/dapr/cli/utils"
+)
+
+// VerifyMode is how the integrity of downloaded binaries is verified.
+type VerifyMode string
+
+const (
+	// VerifyStrict fails when a checksum or signature does not match, or
+	// when no checksum is published for a download or carried by a bundle.
+	// Signatures which cannot be checked for lack of cosign only print a
+	// warning.
+	VerifyStrict VerifyMode = "strict"
+	// VerifyWarn prints a warning instead of failing.
+	VerifyWarn VerifyMode = "warn"
+	// VerifyOff skips verification.
+	VerifyOff VerifyMode = "off"
+
+	checksumFileExt = ".sha256"
+	// Release archives signed keylessly with cosign carry either a sigstore
+	// bundle or a signature and certificate.
+	sigstoreBundleFileExt = ".sigstore.json"
+	signatureFileExt      = ".sig"
+	certificateFileExt    = ".pem"
+
+	cosignCertificateIdentity = `^https://github\.com/dapr/`
+	cosignCertificateIssuer   = "https://token.actions.githubusercontent.com"
+)
+
+var (
+	errAssetNotPublished  = errors.New("not published")
+	errCosignNotInstalled = errors.New("a signature is published but cosign is not installed, so only the checksum was verified")
+	errNoBundleChecksum   = fmt.Errorf("no checksum in %s, the bundle may predate checksums", bundleDetailsFileName)
+)
+
+// VerifyModes lists the valid verify modes.
+var VerifyModes = []string{string(VerifyStrict), string(VerifyWarn), string(VerifyOff)}
+
+// ParseVerifyMode parses the value of the --verify flag.
+func ParseVerifyMode(mode string) (VerifyMode, error) {
+	switch m := VerifyMode(strings.ToLower(strings.TrimSpace(mode))); m {
+	case VerifyStrict, VerifyWarn, VerifyOff:
+		return m, nil
+	case "":
+		return VerifyStrict, nil
+	default:
+		return "", fmt.Errorf("invalid value for --verify: %q. Supported values are %s", mode, strings.Join(VerifyModes, ", "))
+	}
+}
+
+// check applies the mode to a verification error.
+func (m VerifyMode) check(archive string, err error) error {
+	if err == nil || m == VerifyOff {
+		return nil
+	}
+	if m == VerifyWarn {
+		print.WarningStatusEvent(os.Stdout, "WARNING: could not verify %s: %s", path_filepath.Base(archive), err)
+		return nil
+	}
+	return fmt.Errorf("could not verify %s: %w. Use --verify=warn to continue anyway", path_filepath.Base(archive), err)
+}
+
+// warn prints a verification problem which does not fail any mode.
+func (m VerifyMode) warn(archive string, err error) {
+	if m != VerifyOff {
+		print.WarningStatusEvent(os.Stdout, "WARNING: could not fully verify %s: %s", path_filepath.Base(archive), err)
+	}
+}
+
+// verifyDownload verifies an archive downloaded from fileURL against the
+// checksum published next to it, and against the cosign signature if one is
+// published.
+func verifyDownload(archive, fileURL string, mode VerifyMode) error {
+	if mode == VerifyOff {
+		return nil
+	}
+
+	checksum, err := fetchReleaseAsset(fileURL + checksumFileExt)
+	if errors.Is(err, errAssetNotPublished) {
+		err = errors.New("no checksum is published for the release")
+	}
+	if err == nil {
+		err = verifyChecksum(archive, parseChecksum(string(checksum)))
+	}
+	if err = mode.check(archive, err); err != nil {
+		return err
+	}
+
+	err = verifySignature(archive, fileURL)
+	if errors.Is(err, errCosignNotInstalled) {
+		mode.warn(archive, err)
+		return nil
+	}
+	return mode.check(archive, err)
+}
+
+// verifyBundleFile verifies an archive of an air-gapped bundle against the
+// checksums in the details file of the bundle.
+func verifyBundleFile(archive string, checksums map[string]string, mode VerifyMode) error {
+	if mode == VerifyOff {
+		return nil
+	}
+
+	expected, ok := checksums[path_filepath.Base(archive)]
+	if !ok {
+		return mode.check(archive, errNoBundleChecksum)
+	}
+
+	return mode.check(archive, verifyChecksum(archive, expected))
+}
+
+// parseChecksum parses a checksum file, which holds either the checksum or
+// the output of sha256sum.
+func parseChecksum(content string) string {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func verifyChecksum(path, expected string) error {
+	if expected == "" {
+		return errors.New("the published checksum is empty")
+	}
+
+	actual, err := fileChecksum(path)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("SHA-256 checksum mismatch, expected %s but got %s", expected, actual)
+	}
+	return nil
+}
+
+// verifySignature verifies the cosign signature of an archive with the cosign
+// CLI. Archives without a published signature are not verified, and
+// errCosignNotInstalled is returned when cosign is missing.
+func verifySignature(archive, fileURL string) error {
+	dir, err := os.MkdirTemp("", "dapr-verify")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{"verify-blob"}
+	if bundle, err := downloadReleaseAsset(dir, fileURL+sigstoreBundleFileExt); err == nil {
+		args = append(args, "--bundle", bundle)
+	} else if !errors.Is(err, errAssetNotPublished) {
+		return err
+	} else {
+		signature, err := downloadReleaseAsset(dir, fileURL+signatureFileExt)
+		if errors.Is(err, errAssetNotPublished) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		certificate, err := downloadReleaseAsset(dir, fileURL+certificateFileExt)
+		if err != nil {
+			return fmt.Errorf("signature is published without a certificate: %w", err)
+		}
+		args = append(args, "--signature", signature, "--certificate", certificate)
+	}
+
+	cosign, err := exec.LookPath("cosign")
+	if err != nil {
+		return errCosignNotInstalled
+	}
+
+	args = append(args,
+		"--certificate-identity-regexp", cosignCertificateIdentity,
+		"--certificate-oidc-issuer", cosignCertificateIssuer,
+		archive)
+	if out, err := utils.RunCmdAndWait(cosign, args...); err != nil {
+		return fmt.Errorf("signature verification failed: %s", strings.TrimSpace(out+" "+err.Error()))
+	}
+	return nil
+}
+
+// fetchReleaseAsset fetches a small release asset.
+func fetchReleaseAsset(url string) ([]byte, error) {
+	client := http.Client{Timeout: 30 * time.Second, Transport: &http.Transport{Proxy: http.ProxyFromEnvironment}} //nolint:exhaustruct
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errAssetNotPublished
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download of %s failed with %d", url, resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+func downloadReleaseAsset(dir, url string) (string, error) {
+	b, err := fetchReleaseAsset(url)
+	if err != nil {
+		return "", err
+	}
+
+	path := path_filepath.Join(dir, url[strings.LastIndex(url, "/")+1:])
+	return path, os.WriteFile(path, b, 0o600)
+}
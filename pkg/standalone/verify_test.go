@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standalone
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVerifyMode(t *testing.T) {
+	mode, err := ParseVerifyMode("")
+	require.NoError(t, err)
+	assert.Equal(t, VerifyStrict, mode)
+
+	mode, err = ParseVerifyMode("WARN")
+	require.NoError(t, err)
+	assert.Equal(t, VerifyWarn, mode)
+
+	_, err = ParseVerifyMode("lenient")
+	require.ErrorContains(t, err, "strict, warn, off")
+}
+
+func TestVerifyDownload(t *testing.T) {
+	content := []byte("archive content")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	archive := filepath.Join(t.TempDir(), "daprd_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(archive, content, 0o600))
+
+	assets := map[string]string{
+		"/good/daprd_linux_amd64.tar.gz.sha256":     checksum + "  daprd_linux_amd64.tar.gz\n",
+		"/bad/daprd_linux_amd64.tar.gz.sha256":      "0000",
+		"/unsigned/daprd_linux_amd64.tar.gz.sha256": checksum,
+		"/signed/daprd_linux_amd64.tar.gz.sha256":   checksum,
+		"/signed/daprd_linux_amd64.tar.gz.sig":      "signature",
+		"/signed/daprd_linux_amd64.tar.gz.pem":      "certificate",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := assets[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("matching checksum", func(t *testing.T) {
+		require.NoError(t, verifyDownload(archive, server.URL+"/good/daprd_linux_amd64.tar.gz", VerifyStrict))
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		err := verifyDownload(archive, server.URL+"/bad/daprd_linux_amd64.tar.gz", VerifyStrict)
+		require.ErrorContains(t, err, "checksum mismatch")
+		require.NoError(t, verifyDownload(archive, server.URL+"/bad/daprd_linux_amd64.tar.gz", VerifyWarn))
+		require.NoError(t, verifyDownload(archive, server.URL+"/bad/daprd_linux_amd64.tar.gz", VerifyOff))
+	})
+
+	t.Run("missing checksum", func(t *testing.T) {
+		err := verifyDownload(archive, server.URL+"/missing/daprd_linux_amd64.tar.gz", VerifyStrict)
+		require.ErrorContains(t, err, "no checksum is published")
+		require.NoError(t, verifyDownload(archive, server.URL+"/missing/daprd_linux_amd64.tar.gz", VerifyWarn))
+	})
+
+	t.Run("no signature published", func(t *testing.T) {
+		require.NoError(t, verifySignature(archive, server.URL+"/unsigned/daprd_linux_amd64.tar.gz"))
+	})
+
+	t.Run("cosign not installed", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+		require.ErrorIs(t, verifySignature(archive, server.URL+"/signed/daprd_linux_amd64.tar.gz"), errCosignNotInstalled)
+		// The checksum is still verified.
+		require.NoError(t, verifyDownload(archive, server.URL+"/signed/daprd_linux_amd64.tar.gz", VerifyStrict))
+	})
+}
+
+func TestVerifyBundleFile(t *testing.T) {
+	content := []byte("bundled archive")
+	sum := sha256.Sum256(content)
+
+	archive := filepath.Join(t.TempDir(), "daprd_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(archive, content, 0o600))
+
+	require.NoError(t, verifyBundleFile(archive, map[string]string{"daprd_linux_amd64.tar.gz": hex.EncodeToString(sum[:])}, VerifyStrict))
+	require.ErrorContains(t, verifyBundleFile(archive, map[string]string{"daprd_linux_amd64.tar.gz": "abcd"}, VerifyStrict), "checksum mismatch")
+	// Bundles without checksums only fail in strict mode.
+	require.ErrorContains(t, verifyBundleFile(archive, nil, VerifyStrict), "no checksum in details.json")
+	require.NoError(t, verifyBundleFile(archive, nil, VerifyWarn))
+	require.NoError(t, verifyBundleFile(archive, nil, VerifyOff))
+}
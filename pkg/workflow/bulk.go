@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+	"github.com/dapr/durabletask-go/workflow"
+)
+
+type BulkOptions struct {
+	KubernetesMode   bool
+	Namespace        string
+	AppID            string
+	ConnectionString *string
+	TableName        *string
+
+	// InstanceIDs are the instances to operate on. When empty, all instances
+	// matching Filter are used.
+	InstanceIDs []string
+	Filter      Filter
+}
+
+// BulkOp is a workflow operation applied to a single instance.
+type BulkOp func(ctx context.Context, wf *workflow.Client, instanceID string) error
+
+type BulkResult struct {
+	InstanceID string `csv:"INSTANCE ID" json:"instanceID"      yaml:"instanceID"`
+	Result     string `csv:"RESULT"      json:"result"          yaml:"result"`
+	Error      string `csv:"ERROR"       json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+const (
+	BulkResultSuccess = "SUCCESS"
+	BulkResultFailed  = "FAILED"
+)
+
+func TerminateOp(output *string) BulkOp {
+	return func(ctx context.Context, wf *workflow.Client, instanceID string) error {
+		var wopts []workflow.TerminateOptions
+		if output != nil {
+			wopts = append(wopts, workflow.WithOutput(*output))
+		}
+		return wf.TerminateWorkflow(ctx, instanceID, wopts...)
+	}
+}
+
+func SuspendOp(reason string) BulkOp {
+	return func(ctx context.Context, wf *workflow.Client, instanceID string) error {
+		return wf.SuspendWorkflow(ctx, instanceID, reason)
+	}
+}
+
+func ResumeOp(reason string) BulkOp {
+	return func(ctx context.Context, wf *workflow.Client, instanceID string) error {
+		return wf.ResumeWorkflow(ctx, instanceID, reason)
+	}
+}
+
+func RaiseEventOp(name string, input *string) BulkOp {
+	return func(ctx context.Context, wf *workflow.Client, instanceID string) error {
+		var wopts []workflow.RaiseEventOptions
+		if input != nil {
+			wopts = append(wopts, workflow.WithEventPayload(*input))
+		}
+		return wf.RaiseEvent(ctx, instanceID, name, wopts...)
+	}
+}
+
+// BulkTargets returns the instance IDs a bulk operation applies to: the
+// given instance IDs, or otherwise all instances matching the filter.
+func BulkTargets(ctx context.Context, opts BulkOptions) ([]string, error) {
+	if len(opts.InstanceIDs) > 0 {
+		return opts.InstanceIDs, nil
+	}
+
+	list, err := ListWide(ctx, ListOptions{
+		KubernetesMode:   opts.KubernetesMode,
+		Namespace:        opts.Namespace,
+		AppID:            opts.AppID,
+		ConnectionString: opts.ConnectionString,
+		TableName:        opts.TableName,
+		Filter:           opts.Filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(list))
+	for i, w := range list {
+		ids[i] = w.InstanceID
+	}
+	return ids, nil
+}
+
+// Bulk applies op to all given instances with bounded concurrency. Failures
+// of single instances do not stop the others; the result of every instance
+// is returned in the order of instanceIDs.
+func Bulk(ctx context.Context, opts BulkOptions, instanceIDs []string, op BulkOp) ([]*BulkResult, error) {
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode:     opts.KubernetesMode,
+		Namespace:          opts.Namespace,
+		AppID:              opts.AppID,
+		RuntimePath:        runtime.GetDaprRuntimePath(),
+		DBConnectionString: opts.ConnectionString,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Cancel()
+
+	return bulk(ctx, instanceIDs, func(ctx context.Context, instanceID string) error {
+		return op(ctx, cli.WF, instanceID)
+	}), nil
+}
+
+func bulk(ctx context.Context, instanceIDs []string, fn func(context.Context, string) error) []*BulkResult {
+	results := make([]*BulkResult, len(instanceIDs))
+
+	var (
+		eg errgroup.Group
+		mu sync.Mutex
+	)
+	eg.SetLimit(listConcurrency)
+
+	for i, instanceID := range instanceIDs {
+		eg.Go(func() error {
+			res := &BulkResult{InstanceID: instanceID, Result: BulkResultSuccess}
+			if err := fn(ctx, instanceID); err != nil {
+				res.Result = BulkResultFailed
+				res.Error = err.Error()
+			}
+
+			mu.Lock()
+			results[i] = res
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	eg.Wait()
+
+	return results
+}
+
+// ReadInstanceIDs reads workflow instance IDs, one per line. Blank lines and
+// lines starting with '#' are ignored.
+func ReadInstanceIDs(r io.Reader) ([]string, error) {
+	var ids []string
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		ids = append(ids, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read instance IDs: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulk(t *testing.T) {
+	ids := make([]string, 100)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("id-%d", i)
+	}
+
+	var calls atomic.Int32
+	results := bulk(context.Background(), ids, func(_ context.Context, id string) error {
+		calls.Add(1)
+		if id == "id-42" {
+			return errors.New("not found")
+		}
+		return nil
+	})
+
+	assert.Equal(t, int32(100), calls.Load())
+	require.Len(t, results, 100)
+	for i, r := range results {
+		assert.Equal(t, ids[i], r.InstanceID)
+		if i == 42 {
+			assert.Equal(t, BulkResultFailed, r.Result)
+			assert.Equal(t, "not found", r.Error)
+			continue
+		}
+		assert.Equal(t, BulkResultSuccess, r.Result)
+		assert.Empty(t, r.Error)
+	}
+}
+
+func TestReadInstanceIDs(t *testing.T) {
+	ids, err := ReadInstanceIDs(strings.NewReader("abc\n\n# comment\n  def  \nabc\r\nghi"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"abc", "def", "ghi"}, ids)
+
+	ids, err = ReadInstanceIDs(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestBulkTargetsInstanceIDs(t *testing.T) {
+	ids, err := BulkTargets(context.Background(), BulkOptions{InstanceIDs: []string{"a", "b"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids)
+}
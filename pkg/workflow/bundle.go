@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+// BundleVersion is the version of the bundle format written by Export.
+const BundleVersion = 1
+
+// Bundle is a self-contained export of a workflow instance, which can be
+// rendered with 'dapr workflow history --from-file' without a sidecar. The
+// metadata and history events are stored as protojson.
+type Bundle struct {
+	Version    int               `json:"version"`
+	ExportedAt time.Time         `json:"exportedAt"`
+	Namespace  string            `json:"namespace"`
+	AppID      string            `json:"appID"`
+	InstanceID string            `json:"instanceID"`
+	Metadata   json.RawMessage   `json:"metadata,omitempty"`
+	History    []json.RawMessage `json:"history"`
+}
+
+type ExportOptions struct {
+	KubernetesMode bool
+	Namespace      string
+	AppID          string
+	InstanceID     string
+}
+
+// Export captures the metadata and full history of a workflow instance.
+func Export(ctx context.Context, opts ExportOptions) (*Bundle, error) {
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.Namespace,
+		AppID:          opts.AppID,
+		RuntimePath:    runtime.GetDaprRuntimePath(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Cancel()
+
+	meta, err := cli.WF.FetchWorkflowMetadata(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow instance %q: %w", opts.InstanceID, err)
+	}
+
+	history, err := cli.InstanceHistory(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBundle(opts, (*protos.WorkflowMetadata)(meta), history)
+}
+
+// NewBundle builds a bundle from the metadata and history of an instance.
+func NewBundle(opts ExportOptions, meta *protos.WorkflowMetadata, history []*protos.HistoryEvent) (*Bundle, error) {
+	b := &Bundle{
+		Version:    BundleVersion,
+		ExportedAt: time.Now().UTC().Truncate(time.Second),
+		Namespace:  opts.Namespace,
+		AppID:      opts.AppID,
+		InstanceID: opts.InstanceID,
+		History:    make([]json.RawMessage, 0, len(history)),
+	}
+
+	if meta != nil {
+		raw, err := protojson.Marshal(meta)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode workflow metadata: %w", err)
+		}
+		b.Metadata = raw
+	}
+
+	for i, ev := range history {
+		raw, err := protojson.Marshal(ev)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode history event %d: %w", i, err)
+		}
+		b.History = append(b.History, raw)
+	}
+
+	return b, nil
+}
+
+// WriteBundle writes the bundle as indented JSON.
+func WriteBundle(w io.Writer, b *Bundle) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(b)
+}
+
+// ReadBundle reads a bundle written by WriteBundle.
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	var b Bundle
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, fmt.Errorf("failed to decode workflow bundle: %w", err)
+	}
+
+	if b.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported workflow bundle version %d, expecting %d", b.Version, BundleVersion)
+	}
+
+	return &b, nil
+}
+
+// ReadBundleFile reads a bundle from the given file.
+func ReadBundleFile(path string) (*Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadBundle(f)
+}
+
+// Events decodes the history events of the bundle.
+func (b *Bundle) Events() ([]*protos.HistoryEvent, error) {
+	events := make([]*protos.HistoryEvent, 0, len(b.History))
+	for i, raw := range b.History {
+		var ev protos.HistoryEvent
+		if err := protojson.Unmarshal(raw, &ev); err != nil {
+			return nil, fmt.Errorf("failed to decode history event %d: %w", i, err)
+		}
+		events = append(events, &ev)
+	}
+	return events, nil
+}
+
+// WorkflowMetadata decodes the metadata of the bundle, which is nil when the
+// bundle has none.
+func (b *Bundle) WorkflowMetadata() (*protos.WorkflowMetadata, error) {
+	if len(b.Metadata) == 0 {
+		return nil, nil
+	}
+
+	var meta protos.WorkflowMetadata
+	if err := protojson.Unmarshal(b.Metadata, &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode workflow metadata: %w", err)
+	}
+	return &meta, nil
+}
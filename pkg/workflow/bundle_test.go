@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+const testBundleFile = "testdata/bundle.json"
+
+func TestBundleRoundTrip(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	history := testTimelineHistory(start)
+	meta := &protos.WorkflowMetadata{InstanceId: "abc", Name: "OrderWorkflow"}
+
+	b, err := NewBundle(ExportOptions{Namespace: "ns", AppID: "app", InstanceID: "abc"}, meta, history)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, b))
+
+	got, err := ReadBundle(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ns", got.Namespace)
+	assert.Equal(t, "app", got.AppID)
+	assert.Equal(t, "abc", got.InstanceID)
+
+	events, err := got.Events()
+	require.NoError(t, err)
+	require.Len(t, events, len(history))
+	for i := range history {
+		assert.True(t, proto.Equal(history[i], events[i]), "event %d", i)
+	}
+
+	gotMeta, err := got.WorkflowMetadata()
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(meta, gotMeta))
+}
+
+func TestReadBundleVersion(t *testing.T) {
+	_, err := ReadBundle(strings.NewReader(`{"version": 2, "history": []}`))
+	require.ErrorContains(t, err, "unsupported workflow bundle version 2")
+
+	_, err = ReadBundle(strings.NewReader(`not json`))
+	require.Error(t, err)
+}
+
+func TestHistoryFromFile(t *testing.T) {
+	ctx := context.Background()
+	opts := HistoryOptions{FromFile: testBundleFile}
+
+	t.Run("wide", func(t *testing.T) {
+		rows, err := HistoryWide(ctx, opts)
+		require.NoError(t, err)
+		require.Len(t, rows, 10)
+
+		assert.Equal(t, "default", rows[0].Namespace)
+		assert.Equal(t, "orders", rows[0].AppID)
+		assert.Equal(t, "ExecutionStarted", rows[0].Type)
+		assert.Equal(t, "OrderWorkflow", *rows[0].Name)
+		assert.Equal(t, "exec-1", *rows[0].ExecutionID)
+		assert.Equal(t, `input={"orderId":42}`, *rows[0].Attrs)
+
+		assert.Equal(t, "TaskCompleted", rows[3].Type)
+		assert.Equal(t, "scheduledId=0;output=reserved", *rows[3].Attrs)
+		assert.Equal(t, "2.00s", rows[3].Elapsed)
+
+		assert.Equal(t, "TaskFailed", rows[4].Type)
+		assert.Equal(t, "FAILED", rows[4].Status)
+
+		assert.Equal(t, "ChildWorkflowCreated", rows[7].Type)
+		assert.Contains(t, *rows[7].Attrs, "instanceId=child-1")
+
+		assert.Equal(t, "ExecutionCompleted", rows[9].Type)
+		assert.Equal(t, "COMPLETED", rows[9].Status)
+		assert.Equal(t, "execDuration=16.00s", *rows[9].Details)
+	})
+
+	t.Run("short", func(t *testing.T) {
+		rows, err := HistoryShort(ctx, opts)
+		require.NoError(t, err)
+		require.Len(t, rows, 10)
+		assert.Equal(t, "Reserve", rows[1].Name)
+		assert.Equal(t, "0", rows[1].EventID)
+		assert.Equal(t, "-", rows[3].Name)
+		assert.Equal(t, "eventId=0", rows[3].Details)
+	})
+
+	t.Run("timeline", func(t *testing.T) {
+		root, err := HistoryTimeline(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "order-42", root.InstanceID)
+		assert.Equal(t, "OrderWorkflow", root.Name)
+		assert.Equal(t, "COMPLETED", root.Status)
+		require.Len(t, root.Children, 4)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := HistoryWide(ctx, HistoryOptions{FromFile: "testdata/missing.json"})
+		require.Error(t, err)
+	})
+}
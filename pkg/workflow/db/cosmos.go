@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const cosmosAPIVersion = "2018-12-31"
+
+// CosmosClient queries documents through the Azure Cosmos DB REST API, which
+// is served by Azure Cosmos DB as well as by the Cosmos DB emulator.
+type CosmosClient struct {
+	endpoint string
+	key      []byte
+	client   *http.Client
+	now      func() time.Time
+}
+
+// Cosmos parses a Cosmos DB connection string of the form
+// 'AccountEndpoint=https://localhost:8081/;AccountKey=<key>;'. As for the
+// Azure SDKs, 'DisableServerCertificateValidation=True' accepts the
+// self-signed certificate of the Cosmos DB emulator.
+func Cosmos(connString string) (*CosmosClient, error) {
+	var (
+		endpoint, key string
+		insecure      bool
+	)
+	for _, part := range strings.Split(connString, ";") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(k) {
+		case "accountendpoint":
+			endpoint = v
+		case "accountkey":
+			key = v
+		case "disableservercertificatevalidation":
+			insecure, _ = strconv.ParseBool(v)
+		}
+	}
+
+	if endpoint == "" || key == "" {
+		return nil, errors.New("the Cosmos DB connection string requires AccountEndpoint and AccountKey")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Cosmos DB account key: %w", err)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	if insecure {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+		client.Transport = transport
+	}
+
+	return &CosmosClient{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		key:      decoded,
+		client:   client,
+		now:      time.Now,
+	}, nil
+}
+
+type cosmosQuery struct {
+	Query      string           `json:"query"`
+	Parameters []cosmosQueryArg `json:"parameters"`
+}
+
+type cosmosQueryArg struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ListCosmos lists the workflow metadata keys of a state.azure.cosmosdb
+// store, where the key is the id of the document. Documents are spread over
+// partitions, so the query runs across all of them.
+func ListCosmos(ctx context.Context, c *CosmosClient, database, collection string, opts ListOptions) ([]string, error) {
+	body, err := json.Marshal(cosmosQuery{
+		Query: "SELECT c.id FROM c WHERE STARTSWITH(c.id, @prefix) AND ENDSWITH(c.id, @suffix)",
+		Parameters: []cosmosQueryArg{
+			{Name: "@prefix", Value: opts.metadataKeyPrefix()},
+			{Name: "@suffix", Value: metadataKeySuffix},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLink := "dbs/" + database + "/colls/" + collection
+
+	var (
+		keys         []string
+		continuation string
+	)
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/"+resourceLink+"/docs", bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		date := strings.ToLower(c.now().UTC().Format(http.TimeFormat))
+		req.Header.Set("Content-Type", "application/query+json")
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("x-ms-date", date)
+		req.Header.Set("x-ms-version", cosmosAPIVersion)
+		req.Header.Set("x-ms-documentdb-isquery", "True")
+		req.Header.Set("x-ms-documentdb-query-enablecrosspartition", "True")
+		req.Header.Set("Authorization", c.authorization(http.MethodPost, "docs", resourceLink, date))
+		if continuation != "" {
+			req.Header.Set("x-ms-continuation", continuation)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var page struct {
+			Documents []struct {
+				ID string `json:"id"`
+			} `json:"Documents"`
+		}
+		err = decodeCosmosResponse(resp, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, doc := range page.Documents {
+			keys = append(keys, doc.ID)
+		}
+
+		continuation = resp.Header.Get("x-ms-continuation")
+		if continuation == "" {
+			break
+		}
+	}
+
+	return filterKeys(keys, opts), nil
+}
+
+func decodeCosmosResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("cosmos DB query failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// authorization returns the master key authorization header of a request.
+func (c *CosmosClient) authorization(verb, resourceType, resourceLink, date string) string {
+	payload := strings.ToLower(verb) + "\n" +
+		strings.ToLower(resourceType) + "\n" +
+		resourceLink + "\n" +
+		strings.ToLower(date) + "\n" +
+		"\n"
+
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(payload))
+	sig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return url.QueryEscape("type=master&ver=1.0&sig=" + sig)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCosmosConnectionString(t *testing.T) {
+	c, err := Cosmos("AccountEndpoint=https://localhost:8081/;AccountKey=" + base64.StdEncoding.EncodeToString([]byte("key")) + ";")
+	require.NoError(t, err)
+	assert.Equal(t, "https://localhost:8081", c.endpoint)
+	assert.Equal(t, []byte("key"), c.key)
+
+	_, err = Cosmos("AccountEndpoint=https://localhost:8081/")
+	require.Error(t, err)
+	_, err = Cosmos("AccountEndpoint=https://localhost:8081/;AccountKey=not-base64!")
+	require.Error(t, err)
+}
+
+func TestListCosmos(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	key := base64.StdEncoding.EncodeToString([]byte("emulator-key"))
+
+	var queries []cosmosQuery
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/dbs/daprdb/colls/state/docs", r.URL.Path)
+		assert.Equal(t, "True", r.Header.Get("x-ms-documentdb-isquery"))
+		assert.Equal(t, "True", r.Header.Get("x-ms-documentdb-query-enablecrosspartition"))
+		assert.Equal(t, "fri, 02 jan 2026 03:04:05 gmt", r.Header.Get("x-ms-date"))
+
+		c := &CosmosClient{key: []byte("emulator-key")}
+		assert.Equal(t, c.authorization("POST", "docs", "dbs/daprdb/colls/state", r.Header.Get("x-ms-date")), r.Header.Get("Authorization"))
+
+		var q cosmosQuery
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&q))
+		queries = append(queries, q)
+
+		if r.Header.Get("x-ms-continuation") == "" {
+			w.Header().Set("x-ms-continuation", "page-2")
+			w.Write([]byte(`{"Documents":[{"id":"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata"}],"_count":1}`))
+			return
+		}
+		w.Write([]byte(`{"Documents":[{"id":"myapp||dapr.internal.default.myapp.workflow||wf-2||metadata"}],"_count":1}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := Cosmos("AccountEndpoint=" + srv.URL + "/;AccountKey=" + key)
+	require.NoError(t, err)
+	c.now = func() time.Time { return now }
+
+	keys, err := ListCosmos(t.Context(), c, "daprdb", "state", ListOptions{Namespace: "default", AppID: "myapp"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata",
+		"myapp||dapr.internal.default.myapp.workflow||wf-2||metadata",
+	}, keys)
+
+	require.Len(t, queries, 2)
+	assert.Equal(t, []cosmosQueryArg{
+		{Name: "@prefix", Value: "myapp||dapr.internal.default.myapp.workflow||"},
+		{Name: "@suffix", Value: "||metadata"},
+	}, queries[0].Parameters)
+}
+
+func TestListCosmosEmulatorCertificate(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Documents":[{"id":"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata"}],"_count":1}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	connString := "AccountEndpoint=" + srv.URL + "/;AccountKey=" + base64.StdEncoding.EncodeToString([]byte("k")) + ";"
+	opts := ListOptions{Namespace: "default", AppID: "myapp"}
+
+	c, err := Cosmos(connString)
+	require.NoError(t, err)
+	_, err = ListCosmos(t.Context(), c, "db", "coll", opts)
+	require.ErrorContains(t, err, "certificate")
+
+	c, err = Cosmos(connString + "DisableServerCertificateValidation=True;")
+	require.NoError(t, err)
+	keys, err := ListCosmos(t.Context(), c, "db", "coll", opts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata"}, keys)
+}
+
+func TestListCosmosError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code":"Unauthorized"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := Cosmos("AccountEndpoint=" + srv.URL + ";AccountKey=" + base64.StdEncoding.EncodeToString([]byte("k")))
+	require.NoError(t, err)
+
+	_, err = ListCosmos(t.Context(), c, "db", "coll", ListOptions{Namespace: "default", AppID: "myapp"})
+	require.ErrorContains(t, err, "status 401")
+}
@@ -13,7 +13,73 @@ limitations under the License.
 
 package db
 
+import "strings"
+
+const metadataKeySuffix = "||metadata"
+
 type ListOptions struct {
 	Namespace string
 	AppID     string
+
+	// KeyPrefix is the keyPrefix metadata of the state store component:
+	// appid (the default), namespace, name, none or a static prefix.
+	KeyPrefix string
+	// StoreName is the component name, used by the name key prefix.
+	StoreName string
+}
+
+// keyPrefix returns the first segment Dapr puts in front of the keys stored
+// in the state store, or an empty string when keys are not prefixed.
+func (o ListOptions) keyPrefix() string {
+	switch strings.ToLower(o.KeyPrefix) {
+	case "", "appid":
+		return o.AppID
+	case "namespace":
+		if o.Namespace == "" {
+			return o.AppID
+		}
+		return o.Namespace + "." + o.AppID
+	case "name":
+		return o.StoreName
+	case "none":
+		return ""
+	default:
+		return o.KeyPrefix
+	}
+}
+
+// metadataKeyPrefix returns the prefix shared by the metadata keys of all
+// workflow instances of the app.
+func (o ListOptions) metadataKeyPrefix() string {
+	actorType := "dapr.internal." + o.Namespace + "." + o.AppID + ".workflow||"
+	if p := o.keyPrefix(); p != "" {
+		return p + "||" + actorType
+	}
+	return actorType
+}
+
+// InstanceIDFromKey returns the workflow instance ID of a metadata key, and
+// false when the key is not a workflow metadata key of the app.
+func InstanceIDFromKey(key string, opts ListOptions) (string, bool) {
+	id, ok := strings.CutPrefix(key, opts.metadataKeyPrefix())
+	if !ok {
+		return "", false
+	}
+	id, ok = strings.CutSuffix(id, metadataKeySuffix)
+	if !ok || id == "" || strings.Contains(id, "||") {
+		return "", false
+	}
+	return id, true
+}
+
+// filterKeys keeps the workflow metadata keys of the app, for backends which
+// cannot filter exactly on the server side.
+func filterKeys(keys []string, opts ListOptions) []string {
+	var filtered []string
+	for _, key := range keys {
+		if _, ok := InstanceIDFromKey(key, opts); ok {
+			filtered = append(filtered, key)
+		}
+	}
+	return filtered
 }
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataKeyPrefix(t *testing.T) {
+	tests := map[string]string{
+		"":          "myapp||dapr.internal.default.myapp.workflow||",
+		"appid":     "myapp||dapr.internal.default.myapp.workflow||",
+		"AppID":     "myapp||dapr.internal.default.myapp.workflow||",
+		"namespace": "default.myapp||dapr.internal.default.myapp.workflow||",
+		"name":      "statestore||dapr.internal.default.myapp.workflow||",
+		"none":      "dapr.internal.default.myapp.workflow||",
+		"shared":    "shared||dapr.internal.default.myapp.workflow||",
+	}
+	for keyPrefix, want := range tests {
+		t.Run(keyPrefix, func(t *testing.T) {
+			opts := ListOptions{Namespace: "default", AppID: "myapp", KeyPrefix: keyPrefix, StoreName: "statestore"}
+			assert.Equal(t, want, opts.metadataKeyPrefix())
+		})
+	}
+}
+
+func TestInstanceIDFromKey(t *testing.T) {
+	opts := ListOptions{Namespace: "default", AppID: "myapp"}
+
+	id, ok := InstanceIDFromKey("myapp||dapr.internal.default.myapp.workflow||abc||metadata", opts)
+	assert.True(t, ok)
+	assert.Equal(t, "abc", id)
+
+	for _, key := range []string{
+		"myapp||dapr.internal.default.myapp.workflow||abc||history-000001",
+		"myapp||dapr.internal.default.myapp.workflow||||metadata",
+		"myapp||dapr.internal.default.myapp.workflow||weird||id||metadata",
+		"other||dapr.internal.default.myapp.workflow||abc||metadata",
+		"myapp||dapr.internal.default.myapp.activity||abc||metadata",
+	} {
+		_, ok = InstanceIDFromKey(key, opts)
+		assert.False(t, ok, key)
+	}
+}
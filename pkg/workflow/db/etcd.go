@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Etcd connects to the comma separated etcd endpoints.
+func Etcd(ctx context.Context, endpoints string) (*clientv3.Client, error) {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   strings.Split(endpoints, ","),
+		DialTimeout: 5 * time.Second,
+		Context:     ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// ListEtcd lists the workflow metadata keys of a state.etcd store, which
+// stores every key under the keyPrefixPath of the component.
+func ListEtcd(ctx context.Context, kv clientv3.KV, keyPrefixPath string, opts ListOptions) ([]string, error) {
+	base := strings.TrimSuffix(keyPrefixPath, "/") + "/"
+
+	resp, err := kv.Get(ctx,
+		base+opts.metadataKeyPrefix(),
+		clientv3.WithPrefix(),
+		clientv3.WithKeysOnly(),
+		clientv3.WithLimit(0),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		keys = append(keys, strings.TrimPrefix(string(kv.Key), base))
+	}
+
+	return filterKeys(keys, opts), nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// fakeEtcdKV serves range reads over an in-memory set of keys.
+type fakeEtcdKV struct {
+	clientv3.KV
+	keys []string
+
+	keysOnly bool
+}
+
+func (f *fakeEtcdKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	op := clientv3.OpGet(key, opts...)
+	f.keysOnly = op.IsKeysOnly()
+
+	end := string(op.RangeBytes())
+	resp := &clientv3.GetResponse{}
+	for _, k := range f.keys {
+		if k >= key && (end == "" && k == key || end != "" && k < end) {
+			resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k)})
+		}
+	}
+	sort.Slice(resp.Kvs, func(i, j int) bool { return string(resp.Kvs[i].Key) < string(resp.Kvs[j].Key) })
+	return resp, nil
+}
+
+func TestListEtcd(t *testing.T) {
+	kv := &fakeEtcdKV{keys: []string{
+		"dapr/state/myapp||dapr.internal.default.myapp.workflow||wf-1||metadata",
+		"dapr/state/myapp||dapr.internal.default.myapp.workflow||wf-1||history-000000",
+		"dapr/state/myapp||dapr.internal.default.myapp.workflow||wf-2||metadata",
+		"dapr/state/other||dapr.internal.default.other.workflow||wf-3||metadata",
+		"/myapp||dapr.internal.default.myapp.workflow||wf-4||metadata",
+	}}
+
+	keys, err := ListEtcd(t.Context(), kv, "dapr/state/", ListOptions{Namespace: "default", AppID: "myapp"})
+	require.NoError(t, err)
+	assert.True(t, kv.keysOnly)
+	assert.Equal(t, []string{
+		"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata",
+		"myapp||dapr.internal.default.myapp.workflow||wf-2||metadata",
+	}, keys)
+
+	// Without a keyPrefixPath, keys are stored under '/'.
+	keys, err = ListEtcd(t.Context(), kv, "", ListOptions{Namespace: "default", AppID: "myapp"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"myapp||dapr.internal.default.myapp.workflow||wf-4||metadata"}, keys)
+}
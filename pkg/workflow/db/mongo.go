@@ -15,7 +15,6 @@ package db
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -39,12 +38,7 @@ func Mongo(ctx context.Context, uri string) (*mongo.Client, error) {
 func ListMongo(ctx context.Context, db *mongo.Database, collection string, opts ListOptions) ([]string, error) {
 	coll := db.Collection(collection)
 
-	ns := regexp.QuoteMeta(opts.Namespace)
-	app := regexp.QuoteMeta(opts.AppID)
-
-	prefix := fmt.Sprintf("%s\\|\\|dapr\\.internal\\.%s\\.%s\\.workflow\\|\\|", app, ns, app)
-	suffix := "\\|\\|metadata"
-	regex := fmt.Sprintf("^%s.*%s$", prefix, suffix)
+	regex := "^" + regexp.QuoteMeta(opts.metadataKeyPrefix()) + ".*" + regexp.QuoteMeta(metadataKeySuffix) + "$"
 
 	filter := bson.M{
 		"_id": bson.M{
@@ -15,16 +15,20 @@ package db
 
 import (
 	"context"
-	"fmt"
+	"strings"
 
 	"github.com/redis/go-redis/v9"
 )
 
-func Redis(ctx context.Context, url string) (*redis.Client, error) {
+// Redis connects to the Redis server of the URL. A non-nil database, such as
+// the redisDB of the component, selects the database instead of the URL.
+func Redis(ctx context.Context, url string, database *int) (*redis.Client, error) {
 	opt, err := redis.ParseURL(url)
 	if err != nil {
 		return nil, err
-
+	}
+	if database != nil {
+		opt.DB = *database
 	}
 
 	rdb := redis.NewClient(opt)
@@ -36,8 +40,7 @@ func Redis(ctx context.Context, url string) (*redis.Client, error) {
 }
 
 func ListRedis(ctx context.Context, rdb *redis.Client, opts ListOptions) ([]string, error) {
-	pattern := fmt.Sprintf("%s||dapr.internal.%s.%s.workflow||*||metadata",
-		opts.AppID, opts.Namespace, opts.AppID)
+	pattern := redisGlobEscaper.Replace(opts.metadataKeyPrefix()) + "*" + metadataKeySuffix
 
 	var (
 		cursor uint64
@@ -58,5 +61,7 @@ func ListRedis(ctx context.Context, rdb *redis.Client, opts ListOptions) ([]stri
 		}
 	}
 
-	return keys, nil
+	return filterKeys(keys, opts), nil
 }
+
+var redisGlobEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/kit/ptr"
+)
+
+// fakeRedis is a minimal RESP2 server serving SELECT and SCAN over a fixed
+// set of keys per database, returning one key per SCAN page.
+type fakeRedis struct {
+	addr string
+	keys map[int][]string
+
+	mu       sync.Mutex
+	selected []int
+	patterns []string
+}
+
+func newFakeRedis(t *testing.T, keys map[int][]string) *fakeRedis {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+
+	f := &fakeRedis{addr: lis.Addr().String(), keys: keys}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+
+	return f
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	db := 0
+	for {
+		args, err := readRESPArray(r)
+		if err != nil {
+			return
+		}
+
+		switch strings.ToUpper(args[0]) {
+		case "SELECT":
+			db, _ = strconv.Atoi(args[1])
+			f.mu.Lock()
+			f.selected = append(f.selected, db)
+			f.mu.Unlock()
+			io.WriteString(conn, "+OK\r\n")
+		case "PING":
+			io.WriteString(conn, "+PONG\r\n")
+		case "SCAN":
+			cursor, _ := strconv.Atoi(args[1])
+			pattern := args[3]
+			f.mu.Lock()
+			f.patterns = append(f.patterns, pattern)
+			f.mu.Unlock()
+
+			var page []string
+			next := 0
+			if keys := f.keys[db]; cursor < len(keys) {
+				if ok, _ := path.Match(pattern, keys[cursor]); ok {
+					page = append(page, keys[cursor])
+				}
+				if cursor+1 < len(keys) {
+					next = cursor + 1
+				}
+			}
+
+			nextCursor := strconv.Itoa(next)
+			fmt.Fprintf(conn, "*2\r\n$%d\r\n%s\r\n*%d\r\n", len(nextCursor), nextCursor, len(page))
+			for _, k := range page {
+				fmt.Fprintf(conn, "$%d\r\n%s\r\n", len(k), k)
+			}
+		default:
+			fmt.Fprintf(conn, "-ERR unknown command '%s'\r\n", args[0])
+		}
+	}
+}
+
+func readRESPArray(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, n)
+	for i := range args {
+		if _, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+func TestListRedis(t *testing.T) {
+	srv := newFakeRedis(t, map[int][]string{
+		0: {"myapp||dapr.internal.default.myapp.workflow||wrong-db||metadata"},
+		3: {
+			"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata",
+			"myapp||dapr.internal.default.myapp.workflow||wf-1||history-000000",
+			"other||dapr.internal.default.other.workflow||wf-2||metadata",
+			"myapp||dapr.internal.default.myapp.workflow||wf-3||metadata",
+		},
+	})
+
+	rdb, err := Redis(t.Context(), "redis://"+srv.addr, ptr.Of(3))
+	require.NoError(t, err)
+	t.Cleanup(func() { rdb.Close() })
+
+	keys, err := ListRedis(t.Context(), rdb, ListOptions{Namespace: "default", AppID: "myapp"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"myapp||dapr.internal.default.myapp.workflow||wf-1||metadata",
+		"myapp||dapr.internal.default.myapp.workflow||wf-3||metadata",
+	}, keys)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	assert.Contains(t, srv.selected, 3)
+	assert.Equal(t, "myapp||dapr.internal.default.myapp.workflow||*||metadata", srv.patterns[0])
+}
+
+func TestListRedisEscapesPattern(t *testing.T) {
+	srv := newFakeRedis(t, map[int][]string{0: {}})
+
+	rdb, err := Redis(t.Context(), "redis://"+srv.addr, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { rdb.Close() })
+
+	_, err = ListRedis(t.Context(), rdb, ListOptions{Namespace: "default", AppID: "my*app", KeyPrefix: "none"})
+	require.NoError(t, err)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	assert.Equal(t, `dapr.internal.default.my\*app.workflow||*||metadata`, srv.patterns[0])
+}
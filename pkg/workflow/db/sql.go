@@ -17,6 +17,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -25,6 +26,63 @@ import (
 	_ "github.com/sijms/go-ora/v2"
 )
 
+// SQLTable is the table of a SQL state store.
+type SQLTable struct {
+	// Driver is the database/sql driver name.
+	Driver string
+	// Schema is the schema, or database for MySQL, of the table. When empty
+	// the default of the connection is used.
+	Schema string
+	// Name is the table name. For PostgreSQL and CockroachDB it may be
+	// qualified with the schema as in 'schema.table'.
+	Name string
+	// MetadataName is the metadata table Dapr creates next to the state
+	// table, in the same schema. It holds no keys, but when set it must
+	// exist, which catches connections to another database than the one of
+	// the component.
+	MetadataName string
+}
+
+type sqlDialect struct {
+	quote       func(string) string
+	placeholder string
+	keyColumn   string
+}
+
+var sqlDialects = map[string]sqlDialect{
+	"mysql": {
+		quote:       func(s string) string { return "`" + strings.ReplaceAll(s, "`", "``") + "`" },
+		placeholder: "?",
+		keyColumn:   "id",
+	},
+	"pgx": {
+		quote:       quoteDouble,
+		placeholder: "$1",
+		keyColumn:   "key",
+	},
+	"sqlserver": {
+		quote:       func(s string) string { return "[" + strings.ReplaceAll(s, "]", "]]") + "]" },
+		placeholder: "@p1",
+		keyColumn:   "Key",
+	},
+	"sqlite3": {
+		quote:       quoteDouble,
+		placeholder: "?",
+		keyColumn:   "key",
+	},
+	"oracle": {
+		// Dapr creates the Oracle table with unquoted, so upper-cased,
+		// identifiers.
+		quote:       func(s string) string { return s },
+		placeholder: ":1",
+		keyColumn:   "key",
+	},
+}
+
+func quoteDouble(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
 func SQL(ctx context.Context, driver, connString string) (*sql.DB, error) {
 	db, err := sql.Open(driver, connString)
 	if err != nil {
@@ -36,9 +94,28 @@ func SQL(ctx context.Context, driver, connString string) (*sql.DB, error) {
 	return db, nil
 }
 
-func ListSQL(ctx context.Context, db *sql.DB, table, key string, opts ListOptions) ([]string, error) {
-	query := fmt.Sprintf(`SELECT "%s" FROM "%s" WHERE key LIKE ?;`, key, table)
-	like := opts.AppID + "||dapr.internal." + opts.Namespace + "." + opts.AppID + ".workflow||%||metadata"
+func ListSQL(ctx context.Context, db *sql.DB, table SQLTable, opts ListOptions) ([]string, error) {
+	query, err := listSQLQuery(table)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.MetadataName != "" {
+		check, err := metadataSQLQuery(table)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := db.QueryContext(ctx, check)
+		if err != nil {
+			return nil, fmt.Errorf("metadata table %q of the state store not found, the connection may not point to the database of the component: %w", table.MetadataName, err)
+		}
+		rows.Close()
+	}
+
+	// LIKE treats '_' in app IDs as a wildcard, so the keys are filtered
+	// exactly afterwards.
+	like := opts.metadataKeyPrefix() + "%" + metadataKeySuffix
 
 	rows, err := db.QueryContext(ctx, query, like)
 	if err != nil {
@@ -61,5 +138,45 @@ func ListSQL(ctx context.Context, db *sql.DB, table, key string, opts ListOption
 		return nil, err
 	}
 
-	return keys, nil
+	return filterKeys(keys, opts), nil
+}
+
+func listSQLQuery(table SQLTable) (string, error) {
+	d, ok := sqlDialects[table.Driver]
+	if !ok {
+		return "", fmt.Errorf("unsupported SQL driver: %s", table.Driver)
+	}
+
+	key := d.quote(d.keyColumn)
+	return fmt.Sprintf("SELECT %s FROM %s WHERE %s LIKE %s", key, qualifiedSQLName(d, table, table.Name), key, d.placeholder), nil
+}
+
+// metadataSQLQuery returns a query which fails when the metadata table of
+// the state store does not exist, and returns no rows otherwise.
+func metadataSQLQuery(table SQLTable) (string, error) {
+	d, ok := sqlDialects[table.Driver]
+	if !ok {
+		return "", fmt.Errorf("unsupported SQL driver: %s", table.Driver)
+	}
+
+	return fmt.Sprintf("SELECT 1 FROM %s WHERE 1 = 0", qualifiedSQLName(d, table, table.MetadataName)), nil
+}
+
+// qualifiedSQLName quotes a table name of the state store and qualifies it
+// with the schema of the store.
+func qualifiedSQLName(d sqlDialect, table SQLTable, name string) string {
+	var parts []string
+	if table.Schema != "" {
+		parts = append(parts, table.Schema)
+	}
+	if table.Driver == "pgx" {
+		parts = append(parts, strings.Split(name, ".")...)
+	} else {
+		parts = append(parts, name)
+	}
+	for i, p := range parts {
+		parts[i] = d.quote(p)
+	}
+
+	return strings.Join(parts, ".")
 }
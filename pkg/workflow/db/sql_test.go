@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSQL(t *testing.T) {
+	sqldb, err := SQL(t.Context(), "sqlite3", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { sqldb.Close() })
+
+	_, err = sqldb.Exec(`CREATE TABLE "wfstate" (key TEXT NOT NULL PRIMARY KEY, value TEXT)`)
+	require.NoError(t, err)
+	_, err = sqldb.Exec(`CREATE TABLE "wfmetadata" (key TEXT NOT NULL PRIMARY KEY, value TEXT)`)
+	require.NoError(t, err)
+
+	for _, key := range []string{
+		"my_app||dapr.internal.default.my_app.workflow||wf-1||metadata",
+		"my_app||dapr.internal.default.my_app.workflow||wf-1||history-000000",
+		"my_app||dapr.internal.default.my_app.workflow||wf-2||metadata",
+		// '_' is a LIKE wildcard and must not match other apps.
+		"myXapp||dapr.internal.default.myXapp.workflow||wf-3||metadata",
+		"other||dapr.internal.default.other.workflow||wf-4||metadata",
+	} {
+		_, err = sqldb.Exec(`INSERT INTO "wfstate" (key, value) VALUES (?, '')`, key)
+		require.NoError(t, err)
+	}
+
+	opts := ListOptions{
+		Namespace: "default",
+		AppID:     "my_app",
+	}
+
+	keys, err := ListSQL(t.Context(), sqldb, SQLTable{Driver: "sqlite3", Name: "wfstate", MetadataName: "wfmetadata"}, opts)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"my_app||dapr.internal.default.my_app.workflow||wf-1||metadata",
+		"my_app||dapr.internal.default.my_app.workflow||wf-2||metadata",
+	}, keys)
+
+	_, err = ListSQL(t.Context(), sqldb, SQLTable{Driver: "sqlite3", Name: "wfstate", MetadataName: "metadata"}, opts)
+	require.ErrorContains(t, err, `metadata table "metadata" of the state store not found`)
+}
+
+func TestListSQLQuery(t *testing.T) {
+	tests := []struct {
+		table SQLTable
+		want  string
+	}{
+		{
+			table: SQLTable{Driver: "mysql", Schema: "dapr_state_store", Name: "state"},
+			want:  "SELECT `id` FROM `dapr_state_store`.`state` WHERE `id` LIKE ?",
+		},
+		{
+			table: SQLTable{Driver: "pgx", Name: "myschema.state"},
+			want:  `SELECT "key" FROM "myschema"."state" WHERE "key" LIKE $1`,
+		},
+		{
+			table: SQLTable{Driver: "sqlserver", Schema: "dbo", Name: "state"},
+			want:  "SELECT [Key] FROM [dbo].[state] WHERE [Key] LIKE @p1",
+		},
+		{
+			table: SQLTable{Driver: "sqlite3", Name: "state"},
+			want:  `SELECT "key" FROM "state" WHERE "key" LIKE ?`,
+		},
+		{
+			table: SQLTable{Driver: "oracle", Name: "state"},
+			want:  "SELECT key FROM state WHERE key LIKE :1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table.Driver, func(t *testing.T) {
+			query, err := listSQLQuery(tt.table)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query)
+		})
+	}
+
+	_, err := listSQLQuery(SQLTable{Driver: "unknown", Name: "state"})
+	require.Error(t, err)
+}
+
+func TestMetadataSQLQuery(t *testing.T) {
+	tests := []struct {
+		table SQLTable
+		want  string
+	}{
+		{
+			table: SQLTable{Driver: "mysql", Schema: "dapr_state_store", Name: "state", MetadataName: "dapr_metadata"},
+			want:  "SELECT 1 FROM `dapr_state_store`.`dapr_metadata` WHERE 1 = 0",
+		},
+		{
+			table: SQLTable{Driver: "pgx", Name: "state", MetadataName: "myschema.dapr_metadata"},
+			want:  `SELECT 1 FROM "myschema"."dapr_metadata" WHERE 1 = 0`,
+		},
+		{
+			table: SQLTable{Driver: "sqlserver", Schema: "dbo", Name: "state", MetadataName: "dapr_metadata"},
+			want:  "SELECT 1 FROM [dbo].[dapr_metadata] WHERE 1 = 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table.Driver, func(t *testing.T) {
+			query, err := metadataSQLQuery(tt.table)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query)
+		})
+	}
+}
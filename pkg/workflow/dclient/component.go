@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dapr/cli/pkg/kubernetes"
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+)
+
+// kubernetesSecretStore is the secret store Dapr uses on Kubernetes when a
+// component does not name one.
+const kubernetesSecretStore = "kubernetes"
+
+// secretGetter returns the value of a key of a secret held by a secret store.
+type secretGetter func(ctx context.Context, store, name, key string) (string, error)
+
+// resolveMetadata returns the metadata of the component keyed by lower-cased
+// name, reading secretKeyRef values from the secret store of the component.
+func resolveMetadata(ctx context.Context, comp *v1alpha1.Component, kubernetesMode bool, getSecret secretGetter) (map[string]string, error) {
+	store := comp.Auth.SecretStore
+	if store == "" && kubernetesMode {
+		store = kubernetesSecretStore
+	}
+
+	meta := make(map[string]string, len(comp.Spec.Metadata))
+	for _, m := range comp.Spec.Metadata {
+		value := m.Value.String()
+
+		switch {
+		case m.SecretKeyRef.Name != "":
+			if store == "" {
+				return nil, fmt.Errorf("metadata %q of component %s references a secret, but the component has no auth.secretStore", m.Name, comp.Name)
+			}
+
+			var err error
+			value, err = getSecret(ctx, store, m.SecretKeyRef.Name, m.SecretKeyRef.Key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret %q for metadata %q of component %s: %w", m.SecretKeyRef.Name, m.Name, comp.Name, err)
+			}
+
+		case m.EnvRef != "":
+			// The variable belongs to the environment of the sidecar, which
+			// is only the environment of this process in self-hosted mode.
+			if kubernetesMode {
+				return nil, fmt.Errorf("metadata %q of component %s references the environment variable %s of the sidecar, which cannot be read in Kubernetes mode", m.Name, comp.Name, m.EnvRef)
+			}
+			value = os.Getenv(m.EnvRef)
+		}
+
+		meta[strings.ToLower(m.Name)] = value
+	}
+
+	return meta, nil
+}
+
+// kubernetesSecrets reads secrets from Kubernetes Secrets of the namespace.
+func kubernetesSecrets(namespace string) secretGetter {
+	return func(ctx context.Context, store, name, key string) (string, error) {
+		if store != kubernetesSecretStore {
+			return "", fmt.Errorf("secret store %q is not supported, only the %q secret store can be read", store, kubernetesSecretStore)
+		}
+
+		client, err := kubernetes.Client()
+		if err != nil {
+			return "", err
+		}
+
+		secret, err := client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+
+		if key == "" {
+			key = name
+		}
+		value, ok := secret.Data[key]
+		if !ok {
+			return "", fmt.Errorf("key %q not found in secret %s/%s", key, namespace, name)
+		}
+		return string(value), nil
+	}
+}
+
+// localSecrets reads secrets from the local file and environment variable
+// secret store components of a self-hosted app.
+func localSecrets(comps []v1alpha1.Component) secretGetter {
+	return func(ctx context.Context, store, name, key string) (string, error) {
+		var comp *v1alpha1.Component
+		for i := range comps {
+			if comps[i].Name == store && strings.HasPrefix(comps[i].Spec.Type, "secretstores.") {
+				comp = &comps[i]
+				break
+			}
+		}
+		if comp == nil {
+			return "", fmt.Errorf("secret store %q not found", store)
+		}
+
+		meta := make(map[string]string, len(comp.Spec.Metadata))
+		for _, m := range comp.Spec.Metadata {
+			meta[strings.ToLower(m.Name)] = m.Value.String()
+		}
+
+		switch comp.Spec.Type {
+		case "secretstores.local.env":
+			value, ok := os.LookupEnv(meta["prefix"] + name)
+			if !ok {
+				return "", fmt.Errorf("environment variable %s is not set", meta["prefix"]+name)
+			}
+			return value, nil
+
+		case "secretstores.local.file":
+			separator := meta["nestedseparator"]
+			if separator == "" {
+				separator = ":"
+			}
+			multiValued, _ := strconv.ParseBool(meta["multivalued"])
+
+			return readLocalSecret(meta["secretsfile"], separator, multiValued, name, key)
+
+		default:
+			return "", fmt.Errorf("secret store %q of type %s is not supported, only secretstores.local.file and secretstores.local.env can be read", store, comp.Spec.Type)
+		}
+	}
+}
+
+// readLocalSecret reads a secret from a secretstores.local.file JSON file.
+// Nested objects are flattened with the separator. A multi-valued store
+// keeps the top level objects as secrets with their flattened keys.
+func readLocalSecret(path, separator string, multiValued bool, name, key string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("secret store has no secretsFile")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	var secrets map[string]any
+	if err = json.Unmarshal(b, &secrets); err != nil {
+		return "", fmt.Errorf("failed to parse secrets file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if multiValued {
+		nested, ok := secrets[name].(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("secret %q not found in %s", name, path)
+		}
+		flattenSecrets(nested, "", separator, values)
+		if key == "" {
+			return "", fmt.Errorf("secret %q is multi-valued and requires a key", name)
+		}
+	} else {
+		flattenSecrets(secrets, "", separator, values)
+		// Single-valued secrets only hold a value under their own name.
+		if key != "" && key != name {
+			return "", fmt.Errorf("key %q not found in secret %q", key, name)
+		}
+		key = name
+	}
+
+	value, ok := values[key]
+	if !ok {
+		return "", fmt.Errorf("secret %q not found in %s", key, path)
+	}
+	return value, nil
+}
+
+func flattenSecrets(in map[string]any, prefix, separator string, out map[string]string) {
+	for k, v := range in {
+		if prefix != "" {
+			k = prefix + separator + k
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			flattenSecrets(v, k, separator, out)
+		case string:
+			out[k] = v
+		default:
+			out[k] = fmt.Sprint(v)
+		}
+	}
+}
+
+// connectionString builds the connection string of the store driver from
+// the resolved metadata of the component.
+func connectionString(driver string, meta map[string]string) (string, error) {
+	var conn string
+
+	switch driver {
+	case "redis":
+		if host := meta["redishost"]; host != "" {
+			u := url.URL{Scheme: "redis", Host: host}
+			if tls, _ := strconv.ParseBool(meta["enabletls"]); tls {
+				u.Scheme = "rediss"
+			}
+			if meta["redisusername"] != "" || meta["redispassword"] != "" {
+				u.User = url.UserPassword(meta["redisusername"], meta["redispassword"])
+			}
+			conn = u.String()
+		}
+
+	case "mongodb":
+		conn = meta["connectionstring"]
+		if conn != "" {
+			break
+		}
+
+		scheme, host := "mongodb", meta["host"]
+		if server := meta["server"]; server != "" {
+			scheme, host = "mongodb+srv", server
+		}
+		if host == "" {
+			break
+		}
+
+		u := url.URL{Scheme: scheme, Host: host, Path: "/", RawQuery: strings.TrimPrefix(meta["params"], "?")}
+		if meta["username"] != "" {
+			u.User = url.UserPassword(meta["username"], meta["password"])
+		}
+		conn = u.String()
+
+	case "etcd":
+		conn = meta["endpoints"]
+
+	case "cosmosdb":
+		if meta["url"] != "" && meta["masterkey"] != "" {
+			conn = "AccountEndpoint=" + meta["url"] + ";AccountKey=" + meta["masterkey"] + ";"
+		}
+
+	default:
+		conn = meta["connectionstring"]
+	}
+
+	if conn == "" {
+		return "", fmt.Errorf("no connection details found in the component metadata for the %s driver", driver)
+	}
+
+	return conn, nil
+}
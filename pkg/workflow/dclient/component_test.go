@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dclient
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dapr/dapr/pkg/apis/common"
+	"github.com/dapr/dapr/pkg/apis/components/v1alpha1"
+)
+
+func metadataValue(name, value string) common.NameValuePair {
+	nvp := common.NameValuePair{Name: name}
+	nvp.SetValue([]byte(strconv.Quote(value)))
+	return nvp
+}
+
+func TestResolveMetadata(t *testing.T) {
+	comp := &v1alpha1.Component{
+		Spec: v1alpha1.ComponentSpec{
+			Type: "state.redis",
+			Metadata: []common.NameValuePair{
+				metadataValue("redisHost", "localhost:6379"),
+				{Name: "redisPassword", SecretKeyRef: common.SecretKeyRef{Name: "redis", Key: "password"}},
+			},
+		},
+	}
+	comp.Name = "statestore"
+
+	var gotStore string
+	getSecret := func(_ context.Context, store, name, key string) (string, error) {
+		gotStore = store
+		if name == "redis" && key == "password" {
+			return "s3cret", nil
+		}
+		return "", errors.New("not found")
+	}
+
+	t.Run("kubernetes defaults to the kubernetes secret store", func(t *testing.T) {
+		meta, err := resolveMetadata(t.Context(), comp, true, getSecret)
+		require.NoError(t, err)
+		assert.Equal(t, kubernetesSecretStore, gotStore)
+		assert.Equal(t, map[string]string{"redishost": "localhost:6379", "redispassword": "s3cret"}, meta)
+	})
+
+	t.Run("self-hosted requires a secret store", func(t *testing.T) {
+		_, err := resolveMetadata(t.Context(), comp, false, getSecret)
+		require.ErrorContains(t, err, "auth.secretStore")
+	})
+
+	t.Run("self-hosted uses the secret store of the component", func(t *testing.T) {
+		c := comp.DeepCopy()
+		c.Auth.SecretStore = "localsecrets"
+		_, err := resolveMetadata(t.Context(), c, false, getSecret)
+		require.NoError(t, err)
+		assert.Equal(t, "localsecrets", gotStore)
+	})
+
+	t.Run("secret errors are returned", func(t *testing.T) {
+		c := comp.DeepCopy()
+		c.Spec.Metadata[1].SecretKeyRef.Key = "missing"
+		_, err := resolveMetadata(t.Context(), c, true, getSecret)
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("env references", func(t *testing.T) {
+		t.Setenv("WORKFLOW_TEST_REDIS_HOST", "redis:6379")
+		c := comp.DeepCopy()
+		c.Spec.Metadata = []common.NameValuePair{{Name: "redisHost", EnvRef: "WORKFLOW_TEST_REDIS_HOST"}}
+
+		meta, err := resolveMetadata(t.Context(), c, false, getSecret)
+		require.NoError(t, err)
+		assert.Equal(t, "redis:6379", meta["redishost"])
+
+		_, err = resolveMetadata(t.Context(), c, true, getSecret)
+		require.Error(t, err)
+	})
+}
+
+func TestLocalSecrets(t *testing.T) {
+	dir := t.TempDir()
+	single := filepath.Join(dir, "secrets.json")
+	require.NoError(t, os.WriteFile(single, []byte(`{"pg":{"conn":"host=localhost"},"token":"abc"}`), 0o600))
+
+	comps := []v1alpha1.Component{
+		{Spec: v1alpha1.ComponentSpec{Type: "secretstores.local.file", Metadata: []common.NameValuePair{
+			metadataValue("secretsFile", single),
+		}}},
+		{Spec: v1alpha1.ComponentSpec{Type: "secretstores.local.file", Metadata: []common.NameValuePair{
+			metadataValue("secretsFile", single),
+			metadataValue("multiValued", "true"),
+		}}},
+		{Spec: v1alpha1.ComponentSpec{Type: "secretstores.local.env", Metadata: []common.NameValuePair{
+			metadataValue("prefix", "WORKFLOW_TEST_"),
+		}}},
+		{Spec: v1alpha1.ComponentSpec{Type: "secretstores.azure.keyvault"}},
+	}
+	comps[0].Name = "file"
+	comps[1].Name = "multi"
+	comps[2].Name = "env"
+	comps[3].Name = "vault"
+
+	getSecret := localSecrets(comps)
+	ctx := t.Context()
+
+	value, err := getSecret(ctx, "file", "pg:conn", "")
+	require.NoError(t, err)
+	assert.Equal(t, "host=localhost", value)
+
+	value, err = getSecret(ctx, "file", "token", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", value)
+
+	_, err = getSecret(ctx, "file", "token", "other")
+	require.Error(t, err)
+
+	value, err = getSecret(ctx, "multi", "pg", "conn")
+	require.NoError(t, err)
+	assert.Equal(t, "host=localhost", value)
+
+	_, err = getSecret(ctx, "multi", "pg", "")
+	require.Error(t, err)
+
+	t.Setenv("WORKFLOW_TEST_PASSWORD", "pw")
+	value, err = getSecret(ctx, "env", "PASSWORD", "")
+	require.NoError(t, err)
+	assert.Equal(t, "pw", value)
+
+	_, err = getSecret(ctx, "vault", "x", "")
+	require.ErrorContains(t, err, "not supported")
+
+	_, err = getSecret(ctx, "missing", "x", "")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestConnectionString(t *testing.T) {
+	tests := []struct {
+		driver string
+		meta   map[string]string
+		want   string
+	}{
+		{"redis", map[string]string{"redishost": "localhost:6379"}, "redis://localhost:6379"},
+		{"redis", map[string]string{"redishost": "cache:6380", "redispassword": "p@ss", "enabletls": "true"}, "rediss://:p%40ss@cache:6380"},
+		{"mongodb", map[string]string{"connectionstring": "mongodb://db:27017"}, "mongodb://db:27017"},
+		{"mongodb", map[string]string{"host": "db:27017", "username": "u", "password": "p", "params": "?authSource=admin"}, "mongodb://u:p@db:27017/?authSource=admin"},
+		{"mongodb", map[string]string{"server": "cluster.example.com"}, "mongodb+srv://cluster.example.com/"},
+		{"etcd", map[string]string{"endpoints": "localhost:2379"}, "localhost:2379"},
+		{"cosmosdb", map[string]string{"url": "https://acct.documents.azure.com:443/", "masterkey": "a2V5"}, "AccountEndpoint=https://acct.documents.azure.com:443/;AccountKey=a2V5;"},
+		{"pgx", map[string]string{"connectionstring": "host=localhost"}, "host=localhost"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.driver, func(t *testing.T) {
+			got, err := connectionString(tc.driver, tc.meta)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := connectionString("pgx", map[string]string{})
+	require.ErrorContains(t, err, "no connection details")
+}
@@ -40,7 +40,6 @@ import (
 	"github.com/dapr/durabletask-go/api/protos"
 	"github.com/dapr/durabletask-go/workflow"
 	"github.com/dapr/go-sdk/client"
-	"github.com/dapr/kit/ptr"
 )
 
 const maxHistoryEntries = 1000
@@ -223,11 +222,13 @@ func (c *Client) InstanceIDs(ctx context.Context) ([]string, error) {
 		instanceIDs := make([]string, 0, len(metaKeys))
 		for _, key := range metaKeys {
 			split := strings.Split(key, "||")
-			if len(split) != 4 {
+			if len(split) < 3 {
 				continue
 			}
 
-			instanceIDs = append(instanceIDs, split[2])
+			// The store keys may or may not be prefixed, depending on the
+			// keyPrefix of the component.
+			instanceIDs = append(instanceIDs, split[len(split)-2])
 		}
 
 		return instanceIDs, nil
@@ -283,28 +284,29 @@ func (c *Client) InstanceHistory(ctx context.Context, instanceID string) ([]*pro
 }
 
 func (c *Client) metaKeysFromDB(ctx context.Context) ([]string, error) {
-	if c.dbConnString == nil {
-		return nil, fmt.Errorf("connection string is required for all database drivers for Dapr pre v1.17")
-	}
-
-	var comps []v1alpha1.Component
+	var (
+		comps     []v1alpha1.Component
+		getSecret secretGetter
+	)
 	if c.kubernetesMode {
 		kclient, err := kubernetes.DaprClient()
 		if err != nil {
-			return nil, err
+			return nil, c.errConnectionRequired(err)
 		}
 
 		kcomps, err := kubernetes.ListComponents(kclient, c.ns)
 		if err != nil {
-			return nil, err
+			return nil, c.errConnectionRequired(err)
 		}
 		comps = kcomps.Items
+		getSecret = kubernetesSecrets(c.ns)
 	} else {
 		var err error
 		comps, err = loader.NewLocalLoader(c.appID, c.resourcePaths).Load(ctx)
 		if err != nil {
-			return nil, err
+			return nil, c.errConnectionRequired(err)
 		}
+		getSecret = localSecrets(comps)
 	}
 
 	var comp *v1alpha1.Component
@@ -326,68 +328,133 @@ func (c *Client) metaKeysFromDB(ctx context.Context) ([]string, error) {
 		return nil, err
 	}
 
-	var tableName *string
-	for _, meta := range comp.Spec.Metadata {
-		switch meta.Name {
-		case "tableName":
-			tableName = ptr.Of(meta.Value.String())
+	meta, err := resolveMetadata(ctx, comp, c.kubernetesMode, getSecret)
+	if err != nil {
+		if c.dbConnString == nil {
+			return nil, c.errConnectionRequired(err)
+		}
+		// The connection string was given, so the plain metadata is enough.
+		meta = make(map[string]string, len(comp.Spec.Metadata))
+		for _, m := range comp.Spec.Metadata {
+			meta[strings.ToLower(m.Name)] = m.Value.String()
 		}
 	}
 
+	var connString string
+	if c.dbConnString != nil {
+		connString = *c.dbConnString
+	} else {
+		connString, err = connectionString(driver, meta)
+		if err != nil {
+			return nil, c.errConnectionRequired(fmt.Errorf("component %s: %w", comp.Name, err))
+		}
+	}
+
+	metaOr := func(name, def string) string {
+		if v := meta[strings.ToLower(name)]; v != "" {
+			return v
+		}
+		return def
+	}
+
+	listOpts := db.ListOptions{
+		Namespace: c.ns,
+		AppID:     c.appID,
+		KeyPrefix: meta["keyprefix"],
+		StoreName: comp.Name,
+	}
+
 	switch {
 	case isSQLDriver(driver):
-		if tableName == nil {
-			tableName = ptr.Of("state")
+		table := db.SQLTable{
+			Driver: driver,
+			Name:   metaOr("tableName", "state"),
+		}
+		switch driver {
+		case "mysql":
+			table.Schema = metaOr("schemaName", "dapr_state_store")
+			table.MetadataName = metaOr("metadataTableName", "dapr_metadata")
+		case "sqlserver":
+			table.Schema = metaOr("schemaName", "dbo")
+			table.MetadataName = metaOr("metadataTableName", "dapr_metadata")
+		case "pgx":
+			table.MetadataName = metaOr("metadataTableName", "dapr_metadata")
+		case "sqlite3":
+			table.MetadataName = metaOr("metadataTableName", "metadata")
 		}
 
-		sqldb, err := db.SQL(ctx, driver, *c.dbConnString)
+		sqldb, err := db.SQL(ctx, driver, connString)
 		if err != nil {
 			return nil, err
 		}
 		defer sqldb.Close()
 
-		key := "key"
-		if driver == "mysql" {
-			key = "id"
-		}
-
-		return db.ListSQL(ctx, sqldb, *tableName, key, db.ListOptions{
-			Namespace: c.ns,
-			AppID:     c.appID,
-		})
+		return db.ListSQL(ctx, sqldb, table, listOpts)
 
 	case driver == "redis":
-		client, err := db.Redis(ctx, *c.dbConnString)
+		var database *int
+		if v := meta["redisdb"]; v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid redisDB %q in component %s: %w", v, comp.Name, err)
+			}
+			database = &n
+		}
+
+		client, err := db.Redis(ctx, connString, database)
 		if err != nil {
 			return nil, err
 		}
+		defer client.Close()
 
-		return db.ListRedis(ctx, client, db.ListOptions{
-			Namespace: c.ns,
-			AppID:     c.appID,
-		})
+		return db.ListRedis(ctx, client, listOpts)
 
 	case driver == "mongodb":
-		client, err := db.Mongo(ctx, *c.dbConnString)
+		client, err := db.Mongo(ctx, connString)
 		if err != nil {
 			return nil, err
 		}
+		defer client.Disconnect(ctx)
+
+		database := client.Database(metaOr("databaseName", "daprStore"))
+		return db.ListMongo(ctx, database, metaOr("collectionName", "daprCollection"), listOpts)
 
-		collectionName := "daprCollection"
-		if tableName != nil {
-			collectionName = *tableName
+	case driver == "etcd":
+		client, err := db.Etcd(ctx, connString)
+		if err != nil {
+			return nil, err
 		}
+		defer client.Close()
 
-		return db.ListMongo(ctx, client.Database("daprStore"), collectionName, db.ListOptions{
-			Namespace: c.ns,
-			AppID:     c.appID,
-		})
+		return db.ListEtcd(ctx, client, meta["keyprefixpath"], listOpts)
+
+	case driver == "cosmosdb":
+		database, collection := meta["database"], meta["collection"]
+		if database == "" || collection == "" {
+			return nil, fmt.Errorf("component %s is missing the database or collection metadata", comp.Name)
+		}
+
+		client, err := db.Cosmos(connString)
+		if err != nil {
+			return nil, err
+		}
+
+		return db.ListCosmos(ctx, client, database, collection, listOpts)
 
 	default:
 		return nil, fmt.Errorf("unsupported driver: %s", driver)
 	}
 }
 
+// errConnectionRequired explains, when no connection string was given, that
+// it could not be read from the actor state store component either.
+func (c *Client) errConnectionRequired(err error) error {
+	if c.dbConnString != nil {
+		return err
+	}
+	return fmt.Errorf("connection string is required for Dapr pre v1.17 when it cannot be read from the actor state store component: %w", err)
+}
+
 func driverFromType(v string) (string, error) {
 	switch v {
 	case "state.mysql":
@@ -406,6 +473,18 @@ func driverFromType(v string) (string, error) {
 		return "redis", nil
 	case "state.mongodb":
 		return "mongodb", nil
+	case "state.cassandra":
+		// Dapr does not accept Cassandra as actor state store, as it
+		// supports neither ETags nor transactions.
+		return "", errors.New("the Cassandra state store cannot be an actor state store, so it holds no workflows")
+	case "state.etcd":
+		return "etcd", nil
+	case "state.azure.cosmosdb":
+		return "cosmosdb", nil
+	case "state.in-memory":
+		// The in-memory store lives inside the sidecar process and the
+		// sidecar has no API to list its keys before v1.17.
+		return "", errors.New("the in-memory state store can only be listed by Dapr v1.17 or later, as its keys are only held by the sidecar")
 	default:
 		return "", fmt.Errorf("unsupported state store type: %s", v)
 	}
@@ -102,3 +102,24 @@ func TestInstanceIDsCancelledContext(t *testing.T) {
 	require.Error(t, err)
 	assert.NotContains(t, err.Error(), "connection string is required")
 }
+
+func TestDriverFromType(t *testing.T) {
+	for typ, want := range map[string]string{
+		"state.postgresql":     "pgx",
+		"state.etcd":           "etcd",
+		"state.azure.cosmosdb": "cosmosdb",
+	} {
+		driver, err := driverFromType(typ)
+		require.NoError(t, err, typ)
+		assert.Equal(t, want, driver, typ)
+	}
+
+	_, err := driverFromType("state.in-memory")
+	require.ErrorContains(t, err, "v1.17")
+
+	_, err = driverFromType("state.cassandra")
+	require.ErrorContains(t, err, "cannot be an actor state store")
+
+	_, err = driverFromType("state.unknown")
+	require.Error(t, err)
+}
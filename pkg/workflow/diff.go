@@ -0,0 +1,431 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/fatih/color"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+	"github.com/dapr/cli/utils"
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/kit/ptr"
+)
+
+const (
+	DiffOpEqual   = "equal"
+	DiffOpChanged = "changed"
+	DiffOpAdded   = "added"
+	DiffOpRemoved = "removed"
+
+	// DiffKindEvent is an external event raised on the instance.
+	DiffKindEvent = "event"
+	// DiffKindEventSent is an event sent by the instance to another one.
+	DiffKindEventSent = "eventSent"
+)
+
+type DiffOptions struct {
+	KubernetesMode bool
+	Namespace      string
+	AppID          string
+	InstanceIDA    string
+	InstanceIDB    string
+}
+
+// HistoryDiff is the difference between the histories of two workflow
+// instances, typically an instance and its rerun.
+type HistoryDiff struct {
+	InstanceIDA string       `json:"instanceIdA" yaml:"instanceIdA"`
+	InstanceIDB string       `json:"instanceIdB" yaml:"instanceIdB"`
+	Entries     []*DiffEntry `json:"entries"     yaml:"entries"`
+}
+
+// DiffEntry is a step of the workflow, such as an activity or a timer, as it
+// appears in either or both instances.
+type DiffEntry struct {
+	Op      string `json:"op"                yaml:"op"`
+	Kind    string `json:"kind"              yaml:"kind"`
+	Name    string `json:"name,omitempty"    yaml:"name,omitempty"`
+	EventID *int32 `json:"eventId,omitempty" yaml:"eventId,omitempty"`
+	// Changes lists the fields which differ for a changed step.
+	Changes []string  `json:"changes,omitempty" yaml:"changes,omitempty"`
+	A       *DiffStep `json:"a,omitempty"       yaml:"a,omitempty"`
+	B       *DiffStep `json:"b,omitempty"       yaml:"b,omitempty"`
+	// DurationDeltaSeconds is the duration in B minus the duration in A.
+	DurationDeltaSeconds float64 `json:"durationDeltaSeconds,omitempty" yaml:"durationDeltaSeconds,omitempty"`
+}
+
+// DiffStep is a step of the workflow in one of the instances. Inputs, outputs
+// and failures are trimmed the same way as in the history output.
+type DiffStep struct {
+	Status  string `json:"status"            yaml:"status"`
+	Input   string `json:"input,omitempty"   yaml:"input,omitempty"`
+	Output  string `json:"output,omitempty"  yaml:"output,omitempty"`
+	Failure string `json:"failure,omitempty" yaml:"failure,omitempty"`
+	// OffsetSeconds is the time from the start of the instance until the
+	// step started.
+	OffsetSeconds float64 `json:"offsetSeconds" yaml:"offsetSeconds"`
+	// DurationSeconds is zero while the step is still running.
+	DurationSeconds float64 `json:"durationSeconds" yaml:"durationSeconds"`
+}
+
+// diffStep is a step of a single history, keyed for alignment.
+type diffStep struct {
+	key     string
+	kind    string
+	name    string
+	eventID *int32
+	step    *DiffStep
+}
+
+// Diff fetches the histories of two workflow instances of the app and
+// compares them.
+func Diff(ctx context.Context, opts DiffOptions) (*HistoryDiff, error) {
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.Namespace,
+		AppID:          opts.AppID,
+		RuntimePath:    runtime.GetDaprRuntimePath(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Cancel()
+
+	a, err := cli.InstanceHistory(ctx, opts.InstanceIDA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the history of %s: %w", opts.InstanceIDA, err)
+	}
+	b, err := cli.InstanceHistory(ctx, opts.InstanceIDB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the history of %s: %w", opts.InstanceIDB, err)
+	}
+
+	d := DiffHistories(a, b)
+	d.InstanceIDA = opts.InstanceIDA
+	d.InstanceIDB = opts.InstanceIDB
+	return d, nil
+}
+
+// DiffHistories aligns the steps of two histories by their kind, event ID and
+// name, keeping the order in which they were scheduled, and compares the
+// aligned steps.
+func DiffHistories(a, b []*protos.HistoryEvent) *HistoryDiff {
+	stepsA, stepsB := diffSteps(a), diffSteps(b)
+
+	// Longest common subsequence of the step keys.
+	lcs := make([][]int, len(stepsA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(stepsB)+1)
+	}
+	for i := len(stepsA) - 1; i >= 0; i-- {
+		for j := len(stepsB) - 1; j >= 0; j-- {
+			if stepsA[i].key == stepsB[j].key {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	d := new(HistoryDiff)
+	i, j := 0, 0
+	for i < len(stepsA) || j < len(stepsB) {
+		switch {
+		case i < len(stepsA) && j < len(stepsB) && stepsA[i].key == stepsB[j].key:
+			d.Entries = append(d.Entries, diffPair(stepsA[i], stepsB[j]))
+			i++
+			j++
+		case j < len(stepsB) && (i == len(stepsA) || lcs[i][j+1] >= lcs[i+1][j]):
+			s := stepsB[j]
+			d.Entries = append(d.Entries, &DiffEntry{Op: DiffOpAdded, Kind: s.kind, Name: s.name, EventID: s.eventID, B: s.step})
+			j++
+		default:
+			s := stepsA[i]
+			d.Entries = append(d.Entries, &DiffEntry{Op: DiffOpRemoved, Kind: s.kind, Name: s.name, EventID: s.eventID, A: s.step})
+			i++
+		}
+	}
+
+	return d
+}
+
+func diffPair(a, b *diffStep) *DiffEntry {
+	e := &DiffEntry{Op: DiffOpEqual, Kind: a.kind, Name: a.name, EventID: a.eventID, A: a.step, B: b.step}
+
+	if a.step.Status != b.step.Status {
+		e.Changes = append(e.Changes, "status")
+	}
+	if a.step.Input != b.step.Input {
+		e.Changes = append(e.Changes, "input")
+	}
+	if a.step.Output != b.step.Output {
+		e.Changes = append(e.Changes, "output")
+	}
+	if a.step.Failure != b.step.Failure {
+		e.Changes = append(e.Changes, "failure")
+	}
+	if len(e.Changes) > 0 {
+		e.Op = DiffOpChanged
+	}
+
+	if a.step.DurationSeconds > 0 && b.step.DurationSeconds > 0 {
+		e.DurationDeltaSeconds = b.step.DurationSeconds - a.step.DurationSeconds
+	}
+
+	return e
+}
+
+// diffSteps collects the steps of a history in the order they were started,
+// completing them with the events which finished them.
+func diffSteps(history []*protos.HistoryEvent) []*diffStep {
+	if len(history) == 0 {
+		return nil
+	}
+
+	start := history[0].GetTimestamp().AsTime()
+	offset := func(ev *protos.HistoryEvent) float64 {
+		return ev.GetTimestamp().AsTime().Sub(start).Seconds()
+	}
+
+	var steps []*diffStep
+	open := make(map[string]*diffStep)
+	raised := make(map[string]int)
+
+	add := func(ev *protos.HistoryEvent, kind, name, status string, input *wrapperspb.StringValue, withID bool) *diffStep {
+		s := &diffStep{
+			key:  kind + "/" + name,
+			kind: kind,
+			name: name,
+			step: &DiffStep{
+				Status:        status,
+				Input:         trim(input, 120),
+				OffsetSeconds: offset(ev),
+			},
+		}
+		if withID {
+			s.key = kind + "/" + strconv.Itoa(int(ev.EventId)) + "/" + name
+			s.eventID = ptr.Of(ev.EventId)
+			open[kind+"/"+strconv.Itoa(int(ev.EventId))] = s
+		}
+		steps = append(steps, s)
+		return s
+	}
+
+	finish := func(ev *protos.HistoryEvent, kind string, id int32, status string, output *wrapperspb.StringValue, failure string) {
+		s, ok := open[kind+"/"+strconv.Itoa(int(id))]
+		if !ok {
+			return
+		}
+		s.step.Status = status
+		s.step.Output = trim(output, 120)
+		if failure != "" {
+			s.step.Failure = trim(wrapperspb.String(failure), 160)
+		}
+		s.step.DurationSeconds = offset(ev) - s.step.OffsetSeconds
+	}
+
+	var wf *diffStep
+	for _, ev := range history {
+		switch t := ev.GetEventType().(type) {
+		case *protos.HistoryEvent_ExecutionStarted:
+			if wf == nil {
+				wf = add(ev, SpanKindWorkflow, "", "RUNNING", t.ExecutionStarted.Input, false)
+				wf.key = SpanKindWorkflow
+				wf.name = t.ExecutionStarted.Name
+			}
+		case *protos.HistoryEvent_ExecutionCompleted:
+			if wf != nil {
+				wf.step.Status = deriveStatus(ev)
+				wf.step.Output = trim(t.ExecutionCompleted.Result, 120)
+				if fd := t.ExecutionCompleted.FailureDetails; fd != nil && fd.ErrorMessage != "" {
+					wf.step.Failure = trim(wrapperspb.String(fd.ErrorMessage), 160)
+				}
+				wf.step.DurationSeconds = offset(ev) - wf.step.OffsetSeconds
+			}
+		case *protos.HistoryEvent_ExecutionTerminated:
+			if wf != nil {
+				wf.step.Status = deriveStatus(ev)
+				wf.step.DurationSeconds = offset(ev) - wf.step.OffsetSeconds
+			}
+
+		case *protos.HistoryEvent_TaskScheduled:
+			add(ev, SpanKindActivity, t.TaskScheduled.Name, "SCHEDULED", t.TaskScheduled.Input, true)
+		case *protos.HistoryEvent_TaskCompleted:
+			finish(ev, SpanKindActivity, t.TaskCompleted.TaskScheduledId, "COMPLETED", t.TaskCompleted.Result, "")
+		case *protos.HistoryEvent_TaskFailed:
+			finish(ev, SpanKindActivity, t.TaskFailed.TaskScheduledId, "FAILED", nil, t.TaskFailed.GetFailureDetails().GetErrorMessage())
+
+		case *protos.HistoryEvent_ChildWorkflowInstanceCreated:
+			add(ev, SpanKindChild, t.ChildWorkflowInstanceCreated.Name, "RUNNING", t.ChildWorkflowInstanceCreated.Input, true)
+		case *protos.HistoryEvent_ChildWorkflowInstanceCompleted:
+			finish(ev, SpanKindChild, t.ChildWorkflowInstanceCompleted.TaskScheduledId, "COMPLETED", t.ChildWorkflowInstanceCompleted.Result, "")
+		case *protos.HistoryEvent_ChildWorkflowInstanceFailed:
+			finish(ev, SpanKindChild, t.ChildWorkflowInstanceFailed.TaskScheduledId, "FAILED", nil, t.ChildWorkflowInstanceFailed.GetFailureDetails().GetErrorMessage())
+
+		case *protos.HistoryEvent_TimerCreated:
+			add(ev, SpanKindTimer, t.TimerCreated.GetName(), "PENDING", nil, true)
+		case *protos.HistoryEvent_TimerFired:
+			finish(ev, SpanKindTimer, t.TimerFired.TimerId, "FIRED", nil, "")
+
+		case *protos.HistoryEvent_EventSent:
+			s := add(ev, DiffKindEventSent, t.EventSent.Name, "SENT", t.EventSent.Input, true)
+			s.step.Output = t.EventSent.InstanceId
+		case *protos.HistoryEvent_EventRaised:
+			// Raised events carry no event ID, so repeated events are aligned
+			// by their occurrence.
+			s := add(ev, DiffKindEvent, t.EventRaised.Name, "RAISED", t.EventRaised.Input, false)
+			raised[t.EventRaised.Name]++
+			s.key += "/" + strconv.Itoa(raised[t.EventRaised.Name])
+		}
+	}
+
+	return steps
+}
+
+// RenderDiff writes the diff in unified form: steps only in A are prefixed
+// with '-', steps only in B with '+' and changed steps show the A and B
+// values of the fields which differ. Colours are used when the output is a
+// terminal.
+func RenderDiff(w io.Writer, d *HistoryDiff) error {
+	var (
+		red    = color.New(color.FgRed).SprintFunc()
+		green  = color.New(color.FgGreen).SprintFunc()
+		cyan   = color.New(color.FgCyan).SprintFunc()
+		bold   = color.New(color.Bold).SprintFunc()
+		faint  = color.New(color.Faint).SprintFunc()
+		indent = "      "
+	)
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, bold("--- "+d.InstanceIDA))
+	fmt.Fprintln(bw, bold("+++ "+d.InstanceIDB))
+
+	for _, e := range d.Entries {
+		title := diffTitle(e)
+
+		switch e.Op {
+		case DiffOpRemoved:
+			fmt.Fprintln(bw, red("- "+title))
+			for _, f := range diffStepFields(e.A) {
+				fmt.Fprintln(bw, red("-"+indent+f))
+			}
+
+		case DiffOpAdded:
+			fmt.Fprintln(bw, green("+ "+title))
+			for _, f := range diffStepFields(e.B) {
+				fmt.Fprintln(bw, green("+"+indent+f))
+			}
+
+		case DiffOpChanged:
+			fmt.Fprintln(bw, cyan("@@ "+title+" @@"))
+			for _, field := range e.Changes {
+				a, b := diffField(e, field)
+				fmt.Fprintln(bw, red("-"+indent+field+": "+a))
+				fmt.Fprintln(bw, green("+"+indent+field+": "+b))
+			}
+			if timing := diffTiming(e); timing != "" {
+				fmt.Fprintln(bw, " "+indent+timing)
+			}
+
+		default:
+			line := "  " + title
+			if timing := diffTiming(e); timing != "" {
+				line += "  " + faint(timing)
+			}
+			fmt.Fprintln(bw, line)
+		}
+	}
+
+	return bw.Flush()
+}
+
+func diffTitle(e *DiffEntry) string {
+	title := e.Kind
+	if e.Name != "" {
+		title += " " + e.Name
+	}
+	if e.EventID != nil {
+		title += " [" + strconv.Itoa(int(*e.EventID)) + "]"
+	}
+	return title
+}
+
+func diffStepFields(s *DiffStep) []string {
+	fields := []string{"status: " + s.Status}
+	if s.Input != "" {
+		fields = append(fields, "input: "+s.Input)
+	}
+	if s.Output != "" {
+		fields = append(fields, "output: "+s.Output)
+	}
+	if s.Failure != "" {
+		fields = append(fields, "failure: "+s.Failure)
+	}
+	if s.DurationSeconds > 0 {
+		fields = append(fields, "duration: "+diffDuration(s.DurationSeconds))
+	}
+	return fields
+}
+
+func diffField(e *DiffEntry, field string) (string, string) {
+	get := func(s *DiffStep) string {
+		var v string
+		switch field {
+		case "status":
+			v = s.Status
+		case "input":
+			v = s.Input
+		case "output":
+			v = s.Output
+		case "failure":
+			v = s.Failure
+		}
+		if v == "" {
+			return "-"
+		}
+		return v
+	}
+	return get(e.A), get(e.B)
+}
+
+func diffTiming(e *DiffEntry) string {
+	if e.DurationDeltaSeconds == 0 {
+		return ""
+	}
+	sign := "+"
+	if e.DurationDeltaSeconds < 0 {
+		sign = "-"
+	}
+	return fmt.Sprintf("duration: %s → %s (%s%s)",
+		diffDuration(e.A.DurationSeconds), diffDuration(e.B.DurationSeconds),
+		sign, diffDuration(e.DurationDeltaSeconds))
+}
+
+func diffDuration(seconds float64) string {
+	s := utils.HumanizeDuration(time.Duration(seconds * float64(time.Second)))
+	if s == "" {
+		return "0s"
+	}
+	return s
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+func TestDiffHistories(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := testTimelineHistory(start)
+
+	b := testTimelineHistory(start)
+	// The rerun charged a different amount, which succeeded this time and
+	// took longer, and skipped the timer.
+	b[2].GetTaskScheduled().Input = wrapperspb.String(`{"amount":2}`)
+	b[4] = &protos.HistoryEvent{EventId: -1, Timestamp: timestamppb.New(start.Add(6 * time.Second)), EventType: &protos.HistoryEvent_TaskCompleted{
+		TaskCompleted: &protos.TaskCompletedEvent{TaskScheduledId: 1, Result: wrapperspb.String(`"ok"`)},
+	}}
+	b = append(b[:5], b[7:]...)
+	b = append(b[:len(b)-1], &protos.HistoryEvent{EventId: 4, Timestamp: timestamppb.New(start.Add(15 * time.Second)), EventType: &protos.HistoryEvent_TaskScheduled{
+		TaskScheduled: &protos.TaskScheduledEvent{Name: "Notify"},
+	}}, b[len(b)-1])
+
+	d := DiffHistories(a, b)
+
+	var ops []string
+	for _, e := range d.Entries {
+		ops = append(ops, e.Op+" "+diffTitle(e))
+	}
+	assert.Equal(t, []string{
+		"equal workflow OrderWorkflow",
+		"equal activity Reserve [0]",
+		"changed activity Charge [1]",
+		"removed timer [2]",
+		"equal child ShipWorkflow [3]",
+		"added activity Notify [4]",
+	}, ops)
+
+	charge := d.Entries[2]
+	assert.Equal(t, []string{"status", "input", "output", "failure"}, charge.Changes)
+	assert.Equal(t, "FAILED", charge.A.Status)
+	assert.Equal(t, "card declined", charge.A.Failure)
+	assert.Equal(t, `{"amount":2}`, charge.B.Input)
+	assert.Equal(t, "ok", charge.B.Output)
+	assert.InDelta(t, 2.0, charge.DurationDeltaSeconds, 0.001)
+
+	assert.Nil(t, d.Entries[3].B)
+	assert.Nil(t, d.Entries[5].A)
+}
+
+func TestDiffHistoriesRaisedEvents(t *testing.T) {
+	raise := func(name, input string) *protos.HistoryEvent {
+		return &protos.HistoryEvent{EventId: -1, Timestamp: timestamppb.Now(), EventType: &protos.HistoryEvent_EventRaised{
+			EventRaised: &protos.EventRaisedEvent{Name: name, Input: wrapperspb.String(input)},
+		}}
+	}
+
+	d := DiffHistories(
+		[]*protos.HistoryEvent{raise("approval", "yes"), raise("approval", "no")},
+		[]*protos.HistoryEvent{raise("approval", "yes"), raise("approval", "maybe"), raise("approval", "no")},
+	)
+	require.Len(t, d.Entries, 3)
+	assert.Equal(t, DiffOpEqual, d.Entries[0].Op)
+	assert.Equal(t, DiffOpChanged, d.Entries[1].Op)
+	assert.Equal(t, []string{"input"}, d.Entries[1].Changes)
+	assert.Equal(t, DiffOpAdded, d.Entries[2].Op)
+}
+
+func TestRenderDiff(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := testTimelineHistory(start)
+	b := testTimelineHistory(start)
+	b[1].GetTaskScheduled().Input = wrapperspb.String(`"x"`)
+
+	d := DiffHistories(a, b)
+	d.InstanceIDA, d.InstanceIDB = "wf-1", "wf-2"
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderDiff(&buf, d))
+	assert.Equal(t, `--- wf-1
++++ wf-2
+  workflow OrderWorkflow
+@@ activity Reserve [0] @@
+-      input: -
++      input: x
+  activity Charge [1]
+  timer [2]
+  child ShipWorkflow [3]
+`, buf.String())
+}
@@ -35,6 +35,11 @@ type HistoryOptions struct {
 	Namespace      string
 	AppID          string
 	InstanceID     string
+
+	// FromFile reads the history from a bundle written by 'dapr workflow
+	// export' instead of a sidecar. The namespace, app ID and instance ID are
+	// taken from the bundle.
+	FromFile string
 }
 
 type HistoryOutputWide struct {
@@ -98,6 +103,14 @@ func HistoryShort(ctx context.Context, opts HistoryOptions) ([]*HistoryOutputSho
 }
 
 func HistoryWide(ctx context.Context, opts HistoryOptions) ([]*HistoryOutputWide, error) {
+	if opts.FromFile != "" {
+		history, err := historyFromFile(&opts)
+		if err != nil {
+			return nil, err
+		}
+		return historyRows(opts, history), nil
+	}
+
 	cli, err := dclient.DaprClient(ctx, dclient.Options{
 		KubernetesMode: opts.KubernetesMode,
 		Namespace:      opts.Namespace,
@@ -114,6 +127,25 @@ func HistoryWide(ctx context.Context, opts HistoryOptions) ([]*HistoryOutputWide
 		return nil, err
 	}
 
+	return historyRows(opts, history), nil
+}
+
+// historyFromFile reads the history events of the bundle given in opts and
+// replaces the instance details of opts with those of the bundle.
+func historyFromFile(opts *HistoryOptions) ([]*protos.HistoryEvent, error) {
+	b, err := ReadBundleFile(opts.FromFile)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Namespace = b.Namespace
+	opts.AppID = b.AppID
+	opts.InstanceID = b.InstanceID
+
+	return b.Events()
+}
+
+func historyRows(opts HistoryOptions, history []*protos.HistoryEvent) []*HistoryOutputWide {
 	var rows []*HistoryOutputWide
 	var prevTs time.Time
 	replay := 0
@@ -199,13 +231,20 @@ func HistoryWide(ctx context.Context, opts HistoryOptions) ([]*HistoryOutputWide
 				}
 			}
 		case *protos.HistoryEvent_ChildWorkflowInstanceCreated:
+			row.addAttr("instanceId", t.ChildWorkflowInstanceCreated.InstanceId)
 			if t.ChildWorkflowInstanceCreated.Input != nil {
 				row.addAttr("input", trim(t.ChildWorkflowInstanceCreated.Input, 120))
 			}
 		case *protos.HistoryEvent_ChildWorkflowInstanceCompleted:
+			row.addAttr("scheduledId", fmt.Sprintf("%d", t.ChildWorkflowInstanceCompleted.TaskScheduledId))
 			if t.ChildWorkflowInstanceCompleted.Result != nil {
 				row.addAttr("output", trim(t.ChildWorkflowInstanceCompleted.Result, 120))
 			}
+		case *protos.HistoryEvent_ChildWorkflowInstanceFailed:
+			row.addAttr("scheduledId", fmt.Sprintf("%d", t.ChildWorkflowInstanceFailed.TaskScheduledId))
+			if fd := t.ChildWorkflowInstanceFailed.FailureDetails; fd != nil && fd.ErrorMessage != "" {
+				row.addAttr("errorMsg", trim(wrapperspb.String(fd.ErrorMessage), 160))
+			}
 		case *protos.HistoryEvent_TaskFailed:
 			row.addAttr("scheduledId", fmt.Sprintf("%d", t.TaskFailed.TaskScheduledId))
 			if t.TaskFailed.TaskExecutionId != "" {
@@ -267,7 +306,7 @@ func HistoryWide(ctx context.Context, opts HistoryOptions) ([]*HistoryOutputWide
 		rows = append(rows, row)
 	}
 
-	return rows, nil
+	return rows
 }
 
 func eventTypeName(h *protos.HistoryEvent) string {
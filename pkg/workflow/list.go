@@ -221,6 +221,12 @@ func list(ctx context.Context, instanceIDs []string, cl *dclient.Client, opts Li
 		return nil, err
 	}
 
+	sortListOutput(listOutput)
+
+	return listOutput, nil
+}
+
+func sortListOutput(listOutput []*ListOutputWide) {
 	sort.SliceStable(listOutput, func(i, j int) bool {
 		if listOutput[i].Created.IsZero() {
 			return false
@@ -233,8 +239,6 @@ func list(ctx context.Context, instanceIDs []string, cl *dclient.Client, opts Li
 		}
 		return listOutput[i].InstanceID < listOutput[j].InstanceID
 	})
-
-	return listOutput, nil
 }
 
 func translateTimestampSince(timestamp time.Time) string {
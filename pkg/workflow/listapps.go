@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// appListConcurrency caps the number of apps listed at the same time. Every
+// app already fetches its workflow metadata with listConcurrency.
+const appListConcurrency = 4
+
+// App identifies a Dapr app whose workflows are listed.
+type App struct {
+	Namespace string
+	AppID     string
+}
+
+// AppError is the error of listing the workflows of a single app.
+type AppError struct {
+	App
+	Err error
+}
+
+func (e *AppError) Error() string {
+	return fmt.Sprintf("app %q in namespace %q: %s", e.AppID, e.Namespace, e.Err)
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// ListWideApps lists the workflows of all given apps. The namespace and app
+// ID of opts are replaced by those of every app. Apps which fail to list are
+// skipped and returned as errors, alongside the merged workflows of all other
+// apps.
+func ListWideApps(ctx context.Context, apps []App, opts ListOptions) ([]*ListOutputWide, []*AppError) {
+	return listApps(ctx, apps, opts, ListWide)
+}
+
+func listApps(ctx context.Context, apps []App, opts ListOptions,
+	listFn func(context.Context, ListOptions) ([]*ListOutputWide, error),
+) ([]*ListOutputWide, []*AppError) {
+	var (
+		listOutput []*ListOutputWide
+		appErrs    []*AppError
+		mu         sync.Mutex
+	)
+
+	var eg errgroup.Group
+	eg.SetLimit(appListConcurrency)
+
+	for _, app := range apps {
+		eg.Go(func() error {
+			appOpts := opts
+			appOpts.Namespace = app.Namespace
+			appOpts.AppID = app.AppID
+
+			wide, err := listFn(ctx, appOpts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				appErrs = append(appErrs, &AppError{App: app, Err: err})
+				return nil
+			}
+			listOutput = append(listOutput, wide...)
+			return nil
+		})
+	}
+
+	eg.Wait()
+
+	sortListOutput(listOutput)
+	sort.Slice(appErrs, func(i, j int) bool {
+		if appErrs[i].Namespace != appErrs[j].Namespace {
+			return appErrs[i].Namespace < appErrs[j].Namespace
+		}
+		return appErrs[i].AppID < appErrs[j].AppID
+	})
+
+	return listOutput, appErrs
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListApps(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	errBackend := errors.New("backend unavailable")
+
+	listFn := func(_ context.Context, opts ListOptions) ([]*ListOutputWide, error) {
+		switch opts.AppID {
+		case "broken":
+			return nil, errBackend
+		case "app1":
+			return []*ListOutputWide{
+				{Namespace: opts.Namespace, AppID: opts.AppID, InstanceID: "b", Created: start.Add(2 * time.Minute)},
+			}, nil
+		default:
+			return []*ListOutputWide{
+				{Namespace: opts.Namespace, AppID: opts.AppID, InstanceID: "a", Created: start.Add(time.Minute)},
+				{Namespace: opts.Namespace, AppID: opts.AppID, InstanceID: "c", Created: start.Add(3 * time.Minute)},
+			}, nil
+		}
+	}
+
+	apps := []App{
+		{Namespace: "default", AppID: "app1"},
+		{Namespace: "prod", AppID: "broken"},
+		{Namespace: "prod", AppID: "app2"},
+	}
+
+	list, appErrs := listApps(context.Background(), apps, ListOptions{Namespace: "ignored", AppID: "ignored"}, listFn)
+
+	require.Len(t, list, 3)
+	assert.Equal(t, "a", list[0].InstanceID)
+	assert.Equal(t, "prod", list[0].Namespace)
+	assert.Equal(t, "app2", list[0].AppID)
+	assert.Equal(t, "b", list[1].InstanceID)
+	assert.Equal(t, "default", list[1].Namespace)
+	assert.Equal(t, "c", list[2].InstanceID)
+
+	require.Len(t, appErrs, 1)
+	assert.Equal(t, "broken", appErrs[0].AppID)
+	require.ErrorIs(t, appErrs[0], errBackend)
+	assert.Equal(t, `app "broken" in namespace "prod": backend unavailable`, appErrs[0].Error())
+}
@@ -0,0 +1,480 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+// maxFailureExamples caps the example instance IDs kept per failure group.
+const maxFailureExamples = 3
+
+type StatsOptions struct {
+	KubernetesMode   bool
+	Namespace        string
+	AppID            string
+	ConnectionString *string
+	TableName        *string
+	Filter           Filter
+
+	// SkipHistory skips fetching the instance histories, which leaves the
+	// activity failures empty.
+	SkipHistory bool
+}
+
+// Stats is an aggregated report over the workflow instances of an app.
+type Stats struct {
+	Namespace        string             `json:"namespace"        yaml:"namespace"`
+	AppID            string             `json:"appID"            yaml:"appID"`
+	Total            int                `json:"total"            yaml:"total"`
+	Statuses         []*StatusCount     `json:"statuses"         yaml:"statuses"`
+	Durations        DurationStats      `json:"durations"        yaml:"durations"`
+	Workflows        []*WorkflowStats   `json:"workflows"        yaml:"workflows"`
+	Failures         []*FailureGroup    `json:"failures"         yaml:"failures"`
+	ActivityFailures []*ActivityFailure `json:"activityFailures" yaml:"activityFailures"`
+
+	// SkippedHistories is the number of instances whose history could not be
+	// fetched, for example because they were purged after being listed.
+	SkippedHistories int `json:"skippedHistories" yaml:"skippedHistories"`
+}
+
+type StatusCount struct {
+	Status string `json:"status" yaml:"status"`
+	Count  int    `json:"count"  yaml:"count"`
+}
+
+// DurationStats are the quantiles of the time from creation to the last
+// update of instances in a terminal status, in seconds.
+type DurationStats struct {
+	Count int     `json:"count"      yaml:"count"`
+	Sum   float64 `json:"sumSeconds" yaml:"sumSeconds"`
+	P50   float64 `json:"p50Seconds" yaml:"p50Seconds"`
+	P95   float64 `json:"p95Seconds" yaml:"p95Seconds"`
+	P99   float64 `json:"p99Seconds" yaml:"p99Seconds"`
+}
+
+type WorkflowStats struct {
+	Name      string         `json:"name"      yaml:"name"`
+	Total     int            `json:"total"     yaml:"total"`
+	Statuses  []*StatusCount `json:"statuses"  yaml:"statuses"`
+	Durations DurationStats  `json:"durations" yaml:"durations"`
+}
+
+// FailureGroup counts the failed instances sharing the same normalized
+// failure message.
+type FailureGroup struct {
+	Message     string   `json:"message"     yaml:"message"`
+	Count       int      `json:"count"       yaml:"count"`
+	InstanceIDs []string `json:"instanceIDs" yaml:"instanceIDs"`
+}
+
+// ActivityFailure counts the TaskFailed history events of an activity.
+type ActivityFailure struct {
+	Name      string `json:"name"      yaml:"name"`
+	Failures  int    `json:"failures"  yaml:"failures"`
+	Instances int    `json:"instances" yaml:"instances"`
+	LastError string `json:"lastError" yaml:"lastError"`
+}
+
+// CollectStats lists the workflow instances of an app and, unless
+// SkipHistory is set, fetches their histories to aggregate them into Stats.
+func CollectStats(ctx context.Context, opts StatsOptions) (*Stats, error) {
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode:     opts.KubernetesMode,
+		Namespace:          opts.Namespace,
+		AppID:              opts.AppID,
+		RuntimePath:        runtime.GetDaprRuntimePath(),
+		DBConnectionString: opts.ConnectionString,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Dapr client: %w", err)
+	}
+	defer cli.Cancel()
+
+	instanceIDs, err := cli.InstanceIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wide, err := list(ctx, instanceIDs, cli, ListOptions{
+		KubernetesMode:   opts.KubernetesMode,
+		Namespace:        opts.Namespace,
+		AppID:            opts.AppID,
+		ConnectionString: opts.ConnectionString,
+		TableName:        opts.TableName,
+		Filter:           opts.Filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		histories map[string][]*protos.HistoryEvent
+		skipped   int
+	)
+	if !opts.SkipHistory {
+		histories, skipped, err = fetchHistories(ctx, wide, cli.InstanceHistory)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stats := BuildStats(wide, histories)
+	stats.Namespace = opts.Namespace
+	stats.AppID = opts.AppID
+	stats.SkippedHistories = skipped
+
+	return stats, nil
+}
+
+// fetchHistories fetches the history of every instance. An instance whose
+// history cannot be fetched is skipped and counted, so that an instance purged
+// after being listed does not fail the whole report.
+func fetchHistories(ctx context.Context, wide []*ListOutputWide, fetch func(context.Context, string) ([]*protos.HistoryEvent, error)) (map[string][]*protos.HistoryEvent, int, error) {
+	var (
+		histories = make(map[string][]*protos.HistoryEvent, len(wide))
+		skipped   int
+		eg        errgroup.Group
+		mu        sync.Mutex
+	)
+	eg.SetLimit(listConcurrency)
+
+	for _, w := range wide {
+		eg.Go(func() error {
+			history, err := fetch(ctx, w.InstanceID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				skipped++
+				return nil
+			}
+			histories[w.InstanceID] = history
+			return nil
+		})
+	}
+
+	eg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return histories, skipped, nil
+}
+
+// BuildStats aggregates the listed instances and their histories, keyed by
+// instance ID. Failures and activity failures are ordered by the most
+// frequent first.
+func BuildStats(wide []*ListOutputWide, histories map[string][]*protos.HistoryEvent) *Stats {
+	stats := &Stats{
+		Total:            len(wide),
+		Workflows:        []*WorkflowStats{},
+		Failures:         []*FailureGroup{},
+		ActivityFailures: []*ActivityFailure{},
+	}
+
+	var (
+		statuses      = make(map[string]int)
+		durations     []time.Duration
+		byName        = make(map[string]*WorkflowStats)
+		nameStatuses  = make(map[string]map[string]int)
+		nameDurations = make(map[string][]time.Duration)
+		failures      = make(map[string]*FailureGroup)
+	)
+
+	for _, w := range wide {
+		statuses[w.RuntimeStatus]++
+
+		ws, ok := byName[w.Name]
+		if !ok {
+			ws = &WorkflowStats{Name: w.Name}
+			byName[w.Name] = ws
+			nameStatuses[w.Name] = make(map[string]int)
+			stats.Workflows = append(stats.Workflows, ws)
+		}
+		ws.Total++
+		nameStatuses[w.Name][w.RuntimeStatus]++
+
+		// Running instances have no meaningful duration yet.
+		if slices.Contains(TerminalStatuses, w.RuntimeStatus) && !w.Created.IsZero() {
+			d := w.LastUpdate.Sub(w.Created)
+			durations = append(durations, d)
+			nameDurations[w.Name] = append(nameDurations[w.Name], d)
+		}
+
+		if w.FailureMessage != "" {
+			msg := NormalizeFailureMessage(w.FailureMessage)
+			g, ok := failures[msg]
+			if !ok {
+				g = &FailureGroup{Message: msg}
+				failures[msg] = g
+				stats.Failures = append(stats.Failures, g)
+			}
+			g.Count++
+			if len(g.InstanceIDs) < maxFailureExamples {
+				g.InstanceIDs = append(g.InstanceIDs, w.InstanceID)
+			}
+		}
+	}
+
+	stats.Statuses = statusCounts(statuses)
+	stats.Durations = durationStats(durations)
+
+	for _, ws := range stats.Workflows {
+		ws.Statuses = statusCounts(nameStatuses[ws.Name])
+		ws.Durations = durationStats(nameDurations[ws.Name])
+	}
+	sort.SliceStable(stats.Workflows, func(i, j int) bool {
+		if stats.Workflows[i].Total != stats.Workflows[j].Total {
+			return stats.Workflows[i].Total > stats.Workflows[j].Total
+		}
+		return stats.Workflows[i].Name < stats.Workflows[j].Name
+	})
+
+	sort.SliceStable(stats.Failures, func(i, j int) bool {
+		if stats.Failures[i].Count != stats.Failures[j].Count {
+			return stats.Failures[i].Count > stats.Failures[j].Count
+		}
+		return stats.Failures[i].Message < stats.Failures[j].Message
+	})
+
+	stats.ActivityFailures = activityFailures(wide, histories)
+
+	return stats
+}
+
+func activityFailures(wide []*ListOutputWide, histories map[string][]*protos.HistoryEvent) []*ActivityFailure {
+	var (
+		result    = []*ActivityFailure{}
+		byName    = make(map[string]*ActivityFailure)
+		lastFail  = make(map[string]time.Time)
+		instances = make(map[string]map[string]bool)
+	)
+
+	// Iterate in list order so the result does not depend on map order.
+	for _, w := range wide {
+		scheduled := make(map[int32]string)
+		for _, ev := range histories[w.InstanceID] {
+			switch t := ev.GetEventType().(type) {
+			case *protos.HistoryEvent_TaskScheduled:
+				scheduled[ev.GetEventId()] = t.TaskScheduled.GetName()
+			case *protos.HistoryEvent_TaskFailed:
+				name, ok := scheduled[t.TaskFailed.GetTaskScheduledId()]
+				if !ok {
+					name = "<unknown>"
+				}
+
+				af, ok := byName[name]
+				if !ok {
+					af = &ActivityFailure{Name: name}
+					byName[name] = af
+					instances[name] = make(map[string]bool)
+					result = append(result, af)
+				}
+				af.Failures++
+				instances[name][w.InstanceID] = true
+
+				ts := ev.GetTimestamp().AsTime()
+				if ts.After(lastFail[name]) || af.LastError == "" {
+					lastFail[name] = ts
+					af.LastError = strings.Join(strings.Fields(t.TaskFailed.GetFailureDetails().GetErrorMessage()), " ")
+				}
+			}
+		}
+	}
+
+	for _, af := range result {
+		af.Instances = len(instances[af.Name])
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Failures != result[j].Failures {
+			return result[i].Failures > result[j].Failures
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// statusCounts returns the non-zero counts in the order of RuntimeStatuses,
+// followed by any unknown status.
+func statusCounts(counts map[string]int) []*StatusCount {
+	result := []*StatusCount{}
+	for _, s := range RuntimeStatuses {
+		if counts[s] > 0 {
+			result = append(result, &StatusCount{Status: s, Count: counts[s]})
+		}
+	}
+
+	var unknown []string
+	for s := range counts {
+		if !slices.Contains(RuntimeStatuses, s) {
+			unknown = append(unknown, s)
+		}
+	}
+	sort.Strings(unknown)
+	for _, s := range unknown {
+		result = append(result, &StatusCount{Status: s, Count: counts[s]})
+	}
+
+	return result
+}
+
+func durationStats(durations []time.Duration) DurationStats {
+	if len(durations) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+
+	return DurationStats{
+		Count: len(sorted),
+		Sum:   sum.Seconds(),
+		P50:   percentile(sorted, 0.50).Seconds(),
+		P95:   percentile(sorted, 0.95).Seconds(),
+		P99:   percentile(sorted, 0.99).Seconds(),
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
+
+var (
+	failureUUIDRegex   = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	failureHexRegex    = regexp.MustCompile(`\b(0x)?[0-9a-fA-F]{8,}\b`)
+	failureNumberRegex = regexp.MustCompile(`\d+(\.\d+)?`)
+)
+
+// NormalizeFailureMessage replaces the variable parts of a failure message,
+// such as IDs and numbers, with placeholders so that equal failures of
+// different instances are grouped together.
+func NormalizeFailureMessage(msg string) string {
+	msg = failureUUIDRegex.ReplaceAllString(msg, "<uuid>")
+	msg = failureHexRegex.ReplaceAllStringFunc(msg, func(s string) string {
+		// Words made of the letters a-f only are not IDs.
+		if !strings.ContainsAny(s, "0123456789") {
+			return s
+		}
+		return "<hex>"
+	})
+	msg = failureNumberRegex.ReplaceAllString(msg, "<n>")
+	return strings.Join(strings.Fields(msg), " ")
+}
+
+// WritePrometheus writes the stats in the Prometheus text exposition format.
+// Only the top most frequent failure groups are labelled with their message,
+// all of them when top is 0, as the messages are unbounded.
+func WritePrometheus(w io.Writer, s *Stats, top int) error {
+	pw := &promWriter{w: w}
+	base := []string{"namespace", s.Namespace, "app_id", s.AppID}
+
+	pw.header("dapr_workflow_instances", "gauge", "Number of workflow instances by workflow name and runtime status.")
+	for _, ws := range s.Workflows {
+		for _, sc := range ws.Statuses {
+			pw.sample("dapr_workflow_instances", append(slices.Clone(base), "name", ws.Name, "status", sc.Status), float64(sc.Count))
+		}
+	}
+
+	pw.header("dapr_workflow_duration_seconds", "summary", "Time from creation to last update of workflow instances in a terminal status.")
+	for _, ws := range s.Workflows {
+		if ws.Durations.Count == 0 {
+			continue
+		}
+		labels := append(slices.Clone(base), "name", ws.Name)
+		for _, q := range []struct {
+			quantile string
+			value    float64
+		}{{"0.5", ws.Durations.P50}, {"0.95", ws.Durations.P95}, {"0.99", ws.Durations.P99}} {
+			pw.sample("dapr_workflow_duration_seconds", append(slices.Clone(labels), "quantile", q.quantile), q.value)
+		}
+		pw.sample("dapr_workflow_duration_seconds_sum", labels, ws.Durations.Sum)
+		pw.sample("dapr_workflow_duration_seconds_count", labels, float64(ws.Durations.Count))
+	}
+
+	pw.header("dapr_workflow_failure_groups", "gauge", "Number of distinct normalized failure messages of failed workflow instances.")
+	pw.sample("dapr_workflow_failure_groups", base, float64(len(s.Failures)))
+
+	failures := s.Failures
+	if top > 0 {
+		failures = failures[:min(top, len(failures))]
+	}
+	pw.header("dapr_workflow_failures", "gauge", "Number of failed workflow instances of the most frequent normalized failure messages.")
+	for _, f := range failures {
+		pw.sample("dapr_workflow_failures", append(slices.Clone(base), "message", f.Message), float64(f.Count))
+	}
+
+	pw.header("dapr_workflow_activity_failures", "gauge", "Number of TaskFailed history events by activity name.")
+	for _, af := range s.ActivityFailures {
+		pw.sample("dapr_workflow_activity_failures", append(slices.Clone(base), "activity", af.Name), float64(af.Failures))
+	}
+
+	return pw.err
+}
+
+type promWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (p *promWriter) header(name, typ, help string) {
+	p.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// sample writes a single sample; labels are given as name, value pairs.
+func (p *promWriter) sample(name string, labels []string, value float64) {
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, labels[i]+`="`+promLabelReplacer.Replace(labels[i+1])+`"`)
+	}
+	p.printf("%s{%s} %v\n", name, strings.Join(pairs, ","), value)
+}
+
+func (p *promWriter) printf(format string, args ...any) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, format, args...)
+}
+
+var promLabelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
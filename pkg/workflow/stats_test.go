@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/durabletask-go/api/protos"
+)
+
+func TestBuildStats(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var wide []*ListOutputWide
+	for i := range 10 {
+		wide = append(wide, &ListOutputWide{
+			Name:          "OrderWorkflow",
+			InstanceID:    fmt.Sprintf("order-%d", i),
+			Created:       start,
+			LastUpdate:    start.Add(time.Duration(i+1) * time.Second),
+			RuntimeStatus: "COMPLETED",
+		})
+	}
+	wide[8].RuntimeStatus = "FAILED"
+	wide[8].FailureMessage = "order 123 not found"
+	wide[9].RuntimeStatus = "FAILED"
+	wide[9].FailureMessage = "order 456  not found"
+	wide = append(wide,
+		&ListOutputWide{
+			Name:          "RefundWorkflow",
+			InstanceID:    "refund-0",
+			Created:       start,
+			LastUpdate:    start.Add(time.Hour),
+			RuntimeStatus: "RUNNING",
+		},
+		&ListOutputWide{
+			Name:           "RefundWorkflow",
+			InstanceID:     "refund-1",
+			Created:        start,
+			LastUpdate:     start.Add(time.Minute),
+			RuntimeStatus:  "FAILED",
+			FailureMessage: "payment provider unavailable",
+		},
+	)
+
+	at := func(sec int) *timestamppb.Timestamp {
+		return timestamppb.New(start.Add(time.Duration(sec) * time.Second))
+	}
+	failed := func(sec int, id int32, msg string) *protos.HistoryEvent {
+		return &protos.HistoryEvent{EventId: -1, Timestamp: at(sec), EventType: &protos.HistoryEvent_TaskFailed{
+			TaskFailed: &protos.TaskFailedEvent{TaskScheduledId: id, FailureDetails: &protos.TaskFailureDetails{ErrorMessage: msg}},
+		}}
+	}
+	histories := map[string][]*protos.HistoryEvent{
+		"order-8": {
+			{EventId: 0, Timestamp: at(0), EventType: &protos.HistoryEvent_TaskScheduled{
+				TaskScheduled: &protos.TaskScheduledEvent{Name: "Reserve"},
+			}},
+			failed(1, 0, "out of stock"),
+			{EventId: 1, Timestamp: at(2), EventType: &protos.HistoryEvent_TaskScheduled{
+				TaskScheduled: &protos.TaskScheduledEvent{Name: "Reserve"},
+			}},
+			failed(3, 1, "still out of stock"),
+		},
+		"order-9": {
+			{EventId: 0, Timestamp: at(0), EventType: &protos.HistoryEvent_TaskScheduled{
+				TaskScheduled: &protos.TaskScheduledEvent{Name: "Reserve"},
+			}},
+			failed(1, 0, "out of stock"),
+		},
+		"refund-1": {
+			{EventId: 0, Timestamp: at(0), EventType: &protos.HistoryEvent_TaskScheduled{
+				TaskScheduled: &protos.TaskScheduledEvent{Name: "Refund"},
+			}},
+			failed(1, 0, "timeout"),
+		},
+	}
+
+	stats := BuildStats(wide, histories)
+
+	assert.Equal(t, 12, stats.Total)
+	assert.Equal(t, []*StatusCount{
+		{Status: "RUNNING", Count: 1},
+		{Status: "COMPLETED", Count: 8},
+		{Status: "FAILED", Count: 3},
+	}, stats.Statuses)
+
+	require.Len(t, stats.Workflows, 2)
+	order := stats.Workflows[0]
+	assert.Equal(t, "OrderWorkflow", order.Name)
+	assert.Equal(t, 10, order.Total)
+	assert.Equal(t, 10, order.Durations.Count)
+	assert.InDelta(t, 5.0, order.Durations.P50, 0.001)
+	assert.InDelta(t, 10.0, order.Durations.P95, 0.001)
+	assert.InDelta(t, 10.0, order.Durations.P99, 0.001)
+	assert.InDelta(t, 55.0, order.Durations.Sum, 0.001)
+
+	refund := stats.Workflows[1]
+	assert.Equal(t, "RefundWorkflow", refund.Name)
+	// The running instance is excluded from the durations.
+	assert.Equal(t, 1, refund.Durations.Count)
+	assert.InDelta(t, 60.0, refund.Durations.P50, 0.001)
+	assert.Equal(t, 11, stats.Durations.Count)
+
+	require.Len(t, stats.Failures, 2)
+	assert.Equal(t, "order <n> not found", stats.Failures[0].Message)
+	assert.Equal(t, 2, stats.Failures[0].Count)
+	assert.Equal(t, []string{"order-8", "order-9"}, stats.Failures[0].InstanceIDs)
+	assert.Equal(t, "payment provider unavailable", stats.Failures[1].Message)
+
+	assert.Equal(t, []*ActivityFailure{
+		{Name: "Reserve", Failures: 3, Instances: 2, LastError: "still out of stock"},
+		{Name: "Refund", Failures: 1, Instances: 1, LastError: "timeout"},
+	}, stats.ActivityFailures)
+}
+
+func TestBuildStatsEmpty(t *testing.T) {
+	stats := BuildStats(nil, nil)
+	assert.Equal(t, 0, stats.Total)
+	assert.Empty(t, stats.Statuses)
+	assert.Equal(t, DurationStats{}, stats.Durations)
+	assert.NotNil(t, stats.Failures)
+	assert.NotNil(t, stats.ActivityFailures)
+}
+
+func TestFetchHistories(t *testing.T) {
+	wide := []*ListOutputWide{{InstanceID: "a"}, {InstanceID: "purged"}, {InstanceID: "b"}}
+	fetch := func(_ context.Context, instanceID string) ([]*protos.HistoryEvent, error) {
+		if instanceID == "purged" {
+			return nil, errors.New("instance not found")
+		}
+		return []*protos.HistoryEvent{{EventId: 1}}, nil
+	}
+
+	t.Run("failed instances are skipped", func(t *testing.T) {
+		histories, skipped, err := fetchHistories(context.Background(), wide, fetch)
+		require.NoError(t, err)
+		assert.Equal(t, 1, skipped)
+		assert.Len(t, histories, 2)
+		assert.Contains(t, histories, "a")
+		assert.Contains(t, histories, "b")
+	})
+
+	t.Run("canceled context fails", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _, err := fetchHistories(ctx, wide, fetch)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNormalizeFailureMessage(t *testing.T) {
+	tests := map[string]string{
+		"order 123 not found": "order <n> not found",
+		"instance 3f2b8c1e-9d4a-4b7e-8f00-1a2b3c4d5e6f failed":       "instance <uuid> failed",
+		"bad pointer 0x7ffe12ab and hash deadbeef0123 in\n  request": "bad pointer <hex> and hash <hex> in request",
+		"took 1.5s after 3 retries":                                  "took <n>s after <n> retries",
+		"accessed facade failed":                                     "accessed facade failed",
+	}
+	for in, want := range tests {
+		t.Run(in, func(t *testing.T) {
+			assert.Equal(t, want, NormalizeFailureMessage(in))
+		})
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	stats := &Stats{
+		Namespace: "default",
+		AppID:     "orders",
+		Workflows: []*WorkflowStats{{
+			Name:      "OrderWorkflow",
+			Statuses:  []*StatusCount{{Status: "COMPLETED", Count: 2}},
+			Durations: DurationStats{Count: 2, Sum: 3, P50: 1, P95: 2, P99: 2},
+		}},
+		Failures: []*FailureGroup{
+			{Message: `say "hi"\now`, Count: 2},
+			{Message: "timeout after <n>s", Count: 1},
+		},
+		ActivityFailures: []*ActivityFailure{{Name: "Reserve", Failures: 4}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WritePrometheus(&buf, stats, 1))
+
+	out := buf.String()
+	assert.Contains(t, out, "# TYPE dapr_workflow_instances gauge\n")
+	assert.Contains(t, out, `dapr_workflow_instances{namespace="default",app_id="orders",name="OrderWorkflow",status="COMPLETED"} 2`+"\n")
+	assert.Contains(t, out, "# TYPE dapr_workflow_duration_seconds summary\n")
+	assert.Contains(t, out, `dapr_workflow_duration_seconds{namespace="default",app_id="orders",name="OrderWorkflow",quantile="0.95"} 2`+"\n")
+	assert.Contains(t, out, `dapr_workflow_duration_seconds_sum{namespace="default",app_id="orders",name="OrderWorkflow"} 3`+"\n")
+	assert.Contains(t, out, `dapr_workflow_duration_seconds_count{namespace="default",app_id="orders",name="OrderWorkflow"} 2`+"\n")
+	assert.Contains(t, out, `dapr_workflow_failure_groups{namespace="default",app_id="orders"} 2`+"\n")
+	assert.Contains(t, out, "# TYPE dapr_workflow_failures gauge\n")
+	assert.Contains(t, out, `dapr_workflow_failures{namespace="default",app_id="orders",message="say \"hi\"\\now"} 2`+"\n")
+	assert.NotContains(t, out, "timeout")
+	assert.Contains(t, out, `dapr_workflow_activity_failures{namespace="default",app_id="orders",activity="Reserve"} 4`+"\n")
+}
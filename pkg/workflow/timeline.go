@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+)
+
+const (
+	SpanKindWorkflow = "workflow"
+	SpanKindActivity = "activity"
+	SpanKindTimer    = "timer"
+	SpanKindChild    = "child"
+)
+
+// maxTimelineDepth limits how deep child workflows are followed.
+const maxTimelineDepth = 8
+
+// TimelineSpan is a span of time in the life of a workflow instance, such as
+// an activity from being scheduled until it completed. Child workflows carry
+// the spans of their own history as children.
+type TimelineSpan struct {
+	Kind       string
+	Name       string
+	InstanceID string
+	EventID    int32
+	Start      time.Time
+	// End is zero while the span is still open.
+	End    time.Time
+	Status string
+	Detail string
+
+	Children []*TimelineSpan
+}
+
+// HistoryTimeline fetches the history of a workflow instance and builds its
+// timeline. Child workflows running on the same app are fetched and nested,
+// unless the history is read from a file.
+func HistoryTimeline(ctx context.Context, opts HistoryOptions) (*TimelineSpan, error) {
+	if opts.FromFile != "" {
+		// Child workflow histories are not part of a bundle.
+		history, err := historyFromFile(&opts)
+		if err != nil {
+			return nil, err
+		}
+		return BuildTimeline(opts.InstanceID, historyRows(opts, history)), nil
+	}
+
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.Namespace,
+		AppID:          opts.AppID,
+		RuntimePath:    runtime.GetDaprRuntimePath(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Cancel()
+
+	history, err := cli.InstanceHistory(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := BuildTimeline(opts.InstanceID, historyRows(opts, history))
+	nestChildren(ctx, cli, opts, root, 1)
+
+	return root, nil
+}
+
+func nestChildren(ctx context.Context, cli *dclient.Client, opts HistoryOptions, span *TimelineSpan, depth int) {
+	if depth > maxTimelineDepth {
+		return
+	}
+
+	for i, child := range span.Children {
+		if child.Kind != SpanKindChild || child.InstanceID == "" {
+			continue
+		}
+
+		// Child workflows may run on another app, in which case the history is
+		// not available through this sidecar and the child is left collapsed.
+		history, err := cli.InstanceHistory(ctx, child.InstanceID)
+		if err != nil || len(history) == 0 {
+			continue
+		}
+
+		nested := BuildTimeline(child.InstanceID, historyRows(opts, history))
+		nested.Kind = SpanKindChild
+		nested.EventID = child.EventID
+		nested.Start = child.Start
+		if !child.End.IsZero() {
+			nested.End = child.End
+		}
+		nested.Status = child.Status
+		nestChildren(ctx, cli, opts, nested, depth+1)
+		span.Children[i] = nested
+	}
+}
+
+// BuildTimeline pairs the history rows of a single workflow instance into
+// spans: tasks from scheduled to completed or failed, timers from created to
+// fired and child workflows from created to completed or failed. The
+// returned span covers the whole instance.
+func BuildTimeline(instanceID string, rows []*HistoryOutputWide) *TimelineSpan {
+	root := &TimelineSpan{
+		Kind:       SpanKindWorkflow,
+		InstanceID: instanceID,
+		Status:     "RUNNING",
+	}
+
+	open := make(map[string]*TimelineSpan)
+	openKey := func(kind string, id int32) string {
+		return kind + "/" + strconv.Itoa(int(id))
+	}
+
+	closeSpan := func(kind, idAttr string, row *HistoryOutputWide, status string) {
+		id, err := strconv.Atoi(rowAttr(row, idAttr))
+		if err != nil {
+			return
+		}
+		span, ok := open[openKey(kind, int32(id))]
+		if !ok {
+			return
+		}
+		span.End = row.Timestamp
+		span.Status = status
+		if msg := rowAttr(row, "errorMsg"); msg != "" {
+			span.Detail = msg
+		}
+		delete(open, openKey(kind, int32(id)))
+	}
+
+	for _, row := range rows {
+		if root.Start.IsZero() {
+			root.Start = row.Timestamp
+		}
+
+		var eventID int32
+		if row.EventID != nil {
+			eventID = *row.EventID
+		}
+
+		switch row.Type {
+		case "ExecutionStarted":
+			if row.Name != nil {
+				root.Name = *row.Name
+			}
+		case "ExecutionCompleted", "ExecutionTerminated":
+			root.End = row.Timestamp
+			root.Status = row.Status
+			if msg := rowAttr(row, "failureMessage"); msg != "" {
+				root.Detail = msg
+			}
+		case "ExecutionSuspended":
+			root.Status = "SUSPENDED"
+		case "ExecutionResumed":
+			root.Status = "RUNNING"
+
+		case "TaskScheduled":
+			span := &TimelineSpan{
+				Kind:    SpanKindActivity,
+				Name:    deref(row.Name),
+				EventID: eventID,
+				Start:   row.Timestamp,
+				Status:  "RUNNING",
+			}
+			open[openKey(SpanKindActivity, eventID)] = span
+			root.Children = append(root.Children, span)
+		case "TaskCompleted":
+			closeSpan(SpanKindActivity, "scheduledId", row, "COMPLETED")
+		case "TaskFailed":
+			closeSpan(SpanKindActivity, "scheduledId", row, "FAILED")
+
+		case "TimerCreated":
+			span := &TimelineSpan{
+				Kind:    SpanKindTimer,
+				Name:    deref(row.Name),
+				EventID: eventID,
+				Start:   row.Timestamp,
+				Status:  "PENDING",
+				Detail:  timerDetail(row),
+			}
+			if span.Name == "" {
+				span.Name = "timer"
+			}
+			if fireAt, err := time.Parse(time.RFC3339, rowAttr(row, "fireAt")); err == nil && fireAt.After(span.Start) {
+				// Show the planned duration until the timer fires.
+				span.End = fireAt
+			}
+			open[openKey(SpanKindTimer, eventID)] = span
+			root.Children = append(root.Children, span)
+		case "TimerFired":
+			closeSpan(SpanKindTimer, "timerId", row, "FIRED")
+
+		case "ChildWorkflowCreated":
+			span := &TimelineSpan{
+				Kind:       SpanKindChild,
+				Name:       deref(row.Name),
+				InstanceID: rowAttr(row, "instanceId"),
+				EventID:    eventID,
+				Start:      row.Timestamp,
+				Status:     "RUNNING",
+			}
+			open[openKey(SpanKindChild, eventID)] = span
+			root.Children = append(root.Children, span)
+		case "ChildWorkflowCompleted":
+			closeSpan(SpanKindChild, "scheduledId", row, "COMPLETED")
+		case "ChildWorkflowFailed":
+			closeSpan(SpanKindChild, "scheduledId", row, "FAILED")
+		}
+	}
+
+	sort.SliceStable(root.Children, func(i, j int) bool {
+		return root.Children[i].Start.Before(root.Children[j].Start)
+	})
+
+	return root
+}
+
+// Duration returns the length of the span, or the time until now when the
+// span is still open.
+func (s *TimelineSpan) Duration() time.Duration {
+	if s.End.IsZero() {
+		return time.Since(s.Start)
+	}
+	return s.End.Sub(s.Start)
+}
+
+// Walk calls fn for the span and all its descendants, depth first.
+func (s *TimelineSpan) Walk(fn func(span *TimelineSpan, depth int)) {
+	s.walk(fn, 0)
+}
+
+func (s *TimelineSpan) walk(fn func(span *TimelineSpan, depth int), depth int) {
+	fn(s, depth)
+	for _, c := range s.Children {
+		c.walk(fn, depth+1)
+	}
+}
+
+func timerDetail(row *HistoryOutputWide) string {
+	origin := rowAttr(row, "origin")
+	if origin == "" {
+		return ""
+	}
+	for _, k := range []string{"eventName", "taskExecId", "instanceId"} {
+		if v := rowAttr(row, k); v != "" {
+			return origin + "(" + v + ")"
+		}
+	}
+	return origin
+}
+
+// rowAttr returns the value of an attribute from the ';' separated Attrs of a
+// history row.
+func rowAttr(row *HistoryOutputWide, key string) string {
+	if row.Attrs == nil {
+		return ""
+	}
+	for _, kv := range strings.Split(*row.Attrs, ";") {
+		if v, ok := strings.CutPrefix(kv, key+"="); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
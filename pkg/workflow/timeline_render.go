@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dapr/cli/utils"
+)
+
+var timelineHTMLTemplate = template.Must(template.New("timeline").Parse(timelineHTML))
+
+const mermaidTimeFormat = "2006-01-02T15:04:05"
+
+// RenderMermaid writes the timeline as a Mermaid Gantt chart. Every workflow
+// instance gets its own section, child workflows follow their parent.
+func RenderMermaid(w io.Writer, root *TimelineSpan) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "gantt")
+	fmt.Fprintf(bw, "    title %s %s (%s)\n", mermaidText(root.Name), mermaidText(root.InstanceID), root.Status)
+	fmt.Fprintln(bw, "    dateFormat YYYY-MM-DDTHH:mm:ss")
+	fmt.Fprintln(bw, "    axisFormat %H:%M:%S")
+
+	var section func(span *TimelineSpan, path string)
+	section = func(span *TimelineSpan, path string) {
+		fmt.Fprintf(bw, "    section %s\n", mermaidText(path))
+		fmt.Fprintf(bw, "    %s :%s, %s\n", mermaidText(span.Name+" "+span.Status), mermaidTag(span.Status), mermaidRange(span))
+
+		for _, c := range span.Children {
+			if len(c.Children) > 0 {
+				continue
+			}
+			label := c.Name
+			if c.Detail != "" {
+				label += " " + c.Detail
+			}
+			fmt.Fprintf(bw, "    %s :%s, %s\n", mermaidText(label), mermaidTag(c.Status), mermaidRange(c))
+		}
+
+		for _, c := range span.Children {
+			if len(c.Children) > 0 {
+				section(c, path+" / "+c.Name)
+			}
+		}
+	}
+	section(root, root.Name)
+
+	return bw.Flush()
+}
+
+func mermaidRange(span *TimelineSpan) string {
+	end := span.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	// Mermaid drops zero length tasks.
+	if !end.After(span.Start) {
+		end = span.Start.Add(time.Second)
+	}
+	return span.Start.UTC().Format(mermaidTimeFormat) + ", " + end.UTC().Format(mermaidTimeFormat)
+}
+
+func mermaidTag(status string) string {
+	switch status {
+	case "COMPLETED", "FIRED":
+		return "done"
+	case "FAILED", "TERMINATED", "CANCELED":
+		return "crit"
+	default:
+		return "active"
+	}
+}
+
+// mermaidText removes characters which have a meaning in Gantt task lines.
+func mermaidText(s string) string {
+	return strings.NewReplacer(":", " ", "#", " ", ";", " ", "\n", " ").Replace(strings.TrimSpace(s))
+}
+
+// RenderDOT writes the timeline as a Graphviz digraph. Spans are chained in
+// start order and child workflows are drawn as nested clusters.
+func RenderDOT(w io.Writer, root *TimelineSpan) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph workflow {")
+	fmt.Fprintln(bw, "  rankdir=LR;")
+	fmt.Fprintln(bw, `  node [shape=box, style="rounded,filled", fontname="Helvetica"];`)
+
+	var n int
+	var cluster func(span *TimelineSpan, indent string) string
+	cluster = func(span *TimelineSpan, indent string) string {
+		n++
+		id := "s" + strconv.Itoa(n)
+
+		fmt.Fprintf(bw, "%ssubgraph cluster_%s {\n", indent, id)
+		fmt.Fprintf(bw, "%s  label=%s;\n", indent, dotQuote(span.Name+" "+span.InstanceID))
+		fmt.Fprintf(bw, "%s  %s [label=%s, fillcolor=%s];\n", indent, id, dotLabel(span), dotColor(span.Status))
+
+		prev := id
+		for _, c := range span.Children {
+			var cid string
+			if len(c.Children) > 0 {
+				cid = cluster(c, indent+"  ")
+			} else {
+				n++
+				cid = "s" + strconv.Itoa(n)
+				fmt.Fprintf(bw, "%s  %s [label=%s, fillcolor=%s];\n", indent, cid, dotLabel(c), dotColor(c.Status))
+			}
+			fmt.Fprintf(bw, "%s  %s -> %s;\n", indent, prev, cid)
+			prev = cid
+		}
+
+		fmt.Fprintf(bw, "%s}\n", indent)
+		return id
+	}
+	cluster(root, "  ")
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func dotLabel(span *TimelineSpan) string {
+	lines := []string{span.Kind + ": " + span.Name, span.Status + " " + utils.HumanizeDuration(span.Duration())}
+	if span.Detail != "" {
+		lines = append(lines, span.Detail)
+	}
+	return dotQuote(strings.Join(lines, "\n"))
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func dotColor(status string) string {
+	switch status {
+	case "COMPLETED", "FIRED":
+		return `"#c8e6c9"`
+	case "FAILED", "TERMINATED", "CANCELED":
+		return `"#ffcdd2"`
+	case "SUSPENDED", "PENDING":
+		return `"#fff9c4"`
+	default:
+		return `"#bbdefb"`
+	}
+}
+
+type htmlRow struct {
+	Indent   string
+	Kind     string
+	Name     string
+	Status   string
+	Detail   string
+	Start    string
+	Duration string
+	Left     string
+	Width    string
+	Class    string
+}
+
+// RenderHTML writes the timeline as a single self-contained HTML page with
+// one bar per span, positioned relative to the whole workflow.
+func RenderHTML(w io.Writer, root *TimelineSpan) error {
+	start, end := root.Start, root.Start
+	root.Walk(func(span *TimelineSpan, _ int) {
+		e := span.End
+		if e.IsZero() {
+			e = time.Now()
+		}
+		if e.After(end) {
+			end = e
+		}
+	})
+	total := end.Sub(start)
+	if total <= 0 {
+		total = time.Second
+	}
+
+	var rows []htmlRow
+	root.Walk(func(span *TimelineSpan, depth int) {
+		left := float64(span.Start.Sub(start)) / float64(total) * 100
+		width := float64(span.Duration()) / float64(total) * 100
+		if width < 0.5 {
+			width = 0.5
+		}
+
+		rows = append(rows, htmlRow{
+			Indent:   strconv.FormatFloat(0.5+float64(depth)*1.5, 'f', 1, 64),
+			Kind:     span.Kind,
+			Name:     span.Name,
+			Status:   span.Status,
+			Detail:   span.Detail,
+			Start:    span.Start.UTC().Format(time.RFC3339),
+			Duration: utils.HumanizeDuration(span.Duration()),
+			Left:     strconv.FormatFloat(left, 'f', 2, 64),
+			Width:    strconv.FormatFloat(width, 'f', 2, 64),
+			Class:    strings.ToLower(mermaidTag(span.Status)),
+		})
+	})
+
+	return timelineHTMLTemplate.Execute(w, map[string]any{
+		"Name":       root.Name,
+		"InstanceID": root.InstanceID,
+		"Status":     root.Status,
+		"Start":      start.UTC().Format(time.RFC3339),
+		"Duration":   utils.HumanizeDuration(total),
+		"Rows":       rows,
+	})
+}
+
+const timelineHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}} {{.InstanceID}}</title>
+<style>
+body { font-family: -apple-system, Helvetica, Arial, sans-serif; margin: 2em; color: #212121; }
+h1 { font-size: 1.3em; margin-bottom: 0.2em; }
+.meta { color: #616161; margin-bottom: 1.5em; }
+table { border-collapse: collapse; width: 100%; }
+td { padding: 3px 8px; border-bottom: 1px solid #eee; font-size: 0.85em; white-space: nowrap; }
+td.track { width: 55%; position: relative; }
+.bar { position: absolute; top: 5px; height: 12px; border-radius: 3px; }
+.done { background: #66bb6a; }
+.crit { background: #ef5350; }
+.active { background: #42a5f5; }
+.kind { color: #757575; }
+.detail { color: #757575; max-width: 30em; overflow: hidden; text-overflow: ellipsis; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<div class="meta">Instance {{.InstanceID}} &middot; {{.Status}} &middot; started {{.Start}} &middot; {{.Duration}}</div>
+<table>
+{{- range .Rows}}
+<tr title="{{.Start}}">
+<td class="kind">{{.Kind}}</td>
+<td style="padding-left: {{.Indent}}em">{{.Name}}</td>
+<td>{{.Status}}</td>
+<td>{{.Duration}}</td>
+<td class="track"><div class="bar {{.Class}}" style="left: {{.Left}}%; width: {{.Width}}%"></div></td>
+<td class="detail">{{.Detail}}</td>
+</tr>
+{{- end}}
+</table>
+</body>
+</html>
+`
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dapr/durabletask-go/api/protos"
+	"github.com/dapr/kit/ptr"
+)
+
+func testTimelineHistory(start time.Time) []*protos.HistoryEvent {
+	at := func(sec int) *timestamppb.Timestamp {
+		return timestamppb.New(start.Add(time.Duration(sec) * time.Second))
+	}
+
+	return []*protos.HistoryEvent{
+		{EventId: -1, Timestamp: at(0), EventType: &protos.HistoryEvent_ExecutionStarted{
+			ExecutionStarted: &protos.ExecutionStartedEvent{Name: "OrderWorkflow"},
+		}},
+		{EventId: 0, Timestamp: at(1), EventType: &protos.HistoryEvent_TaskScheduled{
+			TaskScheduled: &protos.TaskScheduledEvent{Name: "Reserve"},
+		}},
+		{EventId: 1, Timestamp: at(1), EventType: &protos.HistoryEvent_TaskScheduled{
+			TaskScheduled: &protos.TaskScheduledEvent{Name: "Charge"},
+		}},
+		{EventId: -1, Timestamp: at(3), EventType: &protos.HistoryEvent_TaskCompleted{
+			TaskCompleted: &protos.TaskCompletedEvent{TaskScheduledId: 0},
+		}},
+		{EventId: -1, Timestamp: at(4), EventType: &protos.HistoryEvent_TaskFailed{
+			TaskFailed: &protos.TaskFailedEvent{TaskScheduledId: 1, FailureDetails: &protos.TaskFailureDetails{ErrorMessage: "card declined"}},
+		}},
+		{EventId: 2, Timestamp: at(4), EventType: &protos.HistoryEvent_TimerCreated{
+			TimerCreated: &protos.TimerCreatedEvent{
+				FireAt: at(10),
+				Origin: &protos.TimerCreatedEvent_ExternalEvent{ExternalEvent: &protos.TimerOriginExternalEvent{Name: "approval"}},
+			},
+		}},
+		{EventId: -1, Timestamp: at(10), EventType: &protos.HistoryEvent_TimerFired{
+			TimerFired: &protos.TimerFiredEvent{TimerId: 2, FireAt: at(10)},
+		}},
+		{EventId: 3, Timestamp: at(11), EventType: &protos.HistoryEvent_ChildWorkflowInstanceCreated{
+			ChildWorkflowInstanceCreated: &protos.ChildWorkflowInstanceCreatedEvent{InstanceId: "child-1", Name: "ShipWorkflow"},
+		}},
+		{EventId: -1, Timestamp: at(15), EventType: &protos.HistoryEvent_ChildWorkflowInstanceCompleted{
+			ChildWorkflowInstanceCompleted: &protos.ChildWorkflowInstanceCompletedEvent{TaskScheduledId: 3},
+		}},
+		{EventId: -1, Timestamp: at(16), EventType: &protos.HistoryEvent_ExecutionCompleted{
+			ExecutionCompleted: &protos.ExecutionCompletedEvent{WorkflowStatus: protos.OrchestrationStatus_ORCHESTRATION_STATUS_COMPLETED},
+		}},
+	}
+}
+
+func TestBuildTimeline(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	root := BuildTimeline("abc", historyRows(HistoryOptions{}, testTimelineHistory(start)))
+
+	assert.Equal(t, SpanKindWorkflow, root.Kind)
+	assert.Equal(t, "OrderWorkflow", root.Name)
+	assert.Equal(t, "COMPLETED", root.Status)
+	assert.Equal(t, 16*time.Second, root.Duration())
+	require.Len(t, root.Children, 4)
+
+	reserve := root.Children[0]
+	assert.Equal(t, SpanKindActivity, reserve.Kind)
+	assert.Equal(t, "Reserve", reserve.Name)
+	assert.Equal(t, "COMPLETED", reserve.Status)
+	assert.Equal(t, 2*time.Second, reserve.Duration())
+
+	charge := root.Children[1]
+	assert.Equal(t, "Charge", charge.Name)
+	assert.Equal(t, "FAILED", charge.Status)
+	assert.Equal(t, "card declined", charge.Detail)
+
+	timer := root.Children[2]
+	assert.Equal(t, SpanKindTimer, timer.Kind)
+	assert.Equal(t, "FIRED", timer.Status)
+	assert.Equal(t, "externalEvent(approval)", timer.Detail)
+	assert.Equal(t, 6*time.Second, timer.Duration())
+
+	child := root.Children[3]
+	assert.Equal(t, SpanKindChild, child.Kind)
+	assert.Equal(t, "ShipWorkflow", child.Name)
+	assert.Equal(t, "child-1", child.InstanceID)
+	assert.Equal(t, "COMPLETED", child.Status)
+}
+
+func TestBuildTimelineOpenSpans(t *testing.T) {
+	start := time.Now().Add(-time.Minute)
+	history := testTimelineHistory(start)[:3]
+
+	root := BuildTimeline("abc", historyRows(HistoryOptions{}, history))
+	assert.Equal(t, "RUNNING", root.Status)
+	assert.True(t, root.End.IsZero())
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, "RUNNING", root.Children[0].Status)
+	assert.True(t, root.Children[0].End.IsZero())
+}
+
+func TestRenderTimeline(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	root := BuildTimeline("abc", historyRows(HistoryOptions{}, testTimelineHistory(start)))
+
+	// Nest a child workflow as HistoryTimeline does.
+	root.Children[3].Children = []*TimelineSpan{{
+		Kind:   SpanKindActivity,
+		Name:   "Ship<script>",
+		Start:  start.Add(12 * time.Second),
+		End:    start.Add(14 * time.Second),
+		Status: "COMPLETED",
+	}}
+
+	t.Run("mermaid", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, RenderMermaid(&buf, root))
+		out := buf.String()
+		assert.Contains(t, out, "gantt\n")
+		assert.Contains(t, out, "section OrderWorkflow\n")
+		assert.Contains(t, out, "Reserve :done, 2026-01-02T03:04:06, 2026-01-02T03:04:08\n")
+		assert.Contains(t, out, "Charge card declined :crit, ")
+		assert.Contains(t, out, "timer externalEvent(approval) :done, ")
+		assert.Contains(t, out, "section OrderWorkflow / ShipWorkflow\n")
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, RenderDOT(&buf, root))
+		out := buf.String()
+		assert.Contains(t, out, "digraph workflow {")
+		assert.Contains(t, out, `label="activity: Reserve\nCOMPLETED 2.00s"`)
+		assert.Equal(t, 2, bytes.Count(buf.Bytes(), []byte("subgraph cluster_")))
+	})
+
+	t.Run("html", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, RenderHTML(&buf, root))
+		out := buf.String()
+		assert.Contains(t, out, "<!DOCTYPE html>")
+		assert.Contains(t, out, "OrderWorkflow")
+		assert.Contains(t, out, "Ship&lt;script&gt;")
+		assert.NotContains(t, out, "<script>")
+		assert.NotContains(t, out, "http")
+	})
+}
+
+func TestRowAttr(t *testing.T) {
+	row := &HistoryOutputWide{Attrs: ptr.Of("scheduledId=3;output=a=b")}
+	assert.Equal(t, "3", rowAttr(row, "scheduledId"))
+	assert.Equal(t, "a=b", rowAttr(row, "output"))
+	assert.Empty(t, rowAttr(row, "missing"))
+	assert.Empty(t, rowAttr(&HistoryOutputWide{}, "scheduledId"))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dapr/cli/cmd/runtime"
+	"github.com/dapr/cli/pkg/workflow/dclient"
+)
+
+type WatchOptions struct {
+	KubernetesMode bool
+	Namespace      string
+	AppID          string
+	InstanceID     string
+
+	// Until stops watching once the instance reaches the given runtime
+	// status. Watching always stops on a terminal status.
+	Until *string
+	// Interval is the polling interval. Defaults to one second.
+	Interval time.Duration
+}
+
+// Watch polls the metadata and history of a workflow instance and calls fn
+// with every history event appended since the previous poll. It returns the
+// runtime status of the instance once it reaches a terminal status or the
+// status given by opts.Until, or the context error when ctx is cancelled
+// first.
+func Watch(ctx context.Context, opts WatchOptions, fn func(*HistoryOutputWide)) (string, error) {
+	cli, err := dclient.DaprClient(ctx, dclient.Options{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.Namespace,
+		AppID:          opts.AppID,
+		RuntimePath:    runtime.GetDaprRuntimePath(),
+	})
+	if err != nil {
+		return "", err
+	}
+	defer cli.Cancel()
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	hopts := HistoryOptions{
+		KubernetesMode: opts.KubernetesMode,
+		Namespace:      opts.Namespace,
+		AppID:          opts.AppID,
+		InstanceID:     opts.InstanceID,
+	}
+
+	var tail historyTail
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Fetch the metadata before the history so that the final poll of a
+		// finished instance always includes its completion event.
+		meta, err := cli.WF.FetchWorkflowMetadata(ctx, opts.InstanceID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get workflow instance %q: %w", opts.InstanceID, err)
+		}
+
+		history, err := cli.InstanceHistory(ctx, opts.InstanceID)
+		if err != nil {
+			return "", err
+		}
+
+		for _, row := range tail.next(historyRows(hopts, history)) {
+			fn(row)
+		}
+
+		status := meta.String()
+		if watchDone(status, opts.Until) {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// historyTail tracks which history events have already been seen.
+type historyTail struct {
+	seen int
+}
+
+// next returns the rows which were appended since the previous call. A
+// shorter history means the instance was continued as new or rerun, in which
+// case the new history is returned from the start.
+func (h *historyTail) next(rows []*HistoryOutputWide) []*HistoryOutputWide {
+	if len(rows) < h.seen {
+		h.seen = 0
+	}
+
+	newRows := rows[h.seen:]
+	h.seen = len(rows)
+	return newRows
+}
+
+func watchDone(status string, until *string) bool {
+	if until != nil && status == *until {
+		return true
+	}
+	return slices.Contains(TerminalStatuses, status)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Dapr Authors
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dapr/kit/ptr"
+)
+
+func TestHistoryTail(t *testing.T) {
+	rows := func(types ...string) []*HistoryOutputWide {
+		out := make([]*HistoryOutputWide, len(types))
+		for i, typ := range types {
+			out[i] = &HistoryOutputWide{Type: typ}
+		}
+		return out
+	}
+	types := func(rows []*HistoryOutputWide) []string {
+		out := make([]string, len(rows))
+		for i, r := range rows {
+			out[i] = r.Type
+		}
+		return out
+	}
+
+	var tail historyTail
+	assert.Equal(t, []string{"ExecutionStarted"}, types(tail.next(rows("ExecutionStarted"))))
+	assert.Empty(t, tail.next(rows("ExecutionStarted")))
+	assert.Equal(t, []string{"TaskScheduled", "TaskCompleted"},
+		types(tail.next(rows("ExecutionStarted", "TaskScheduled", "TaskCompleted"))))
+
+	// A shorter history after continue-as-new starts over.
+	assert.Equal(t, []string{"ExecutionStarted"}, types(tail.next(rows("ExecutionStarted"))))
+}
+
+func TestWatchDone(t *testing.T) {
+	assert.False(t, watchDone("RUNNING", nil))
+	assert.True(t, watchDone("COMPLETED", nil))
+	assert.True(t, watchDone("FAILED", ptr.Of("SUSPENDED")))
+	assert.True(t, watchDone("SUSPENDED", ptr.Of("SUSPENDED")))
+	assert.False(t, watchDone("PENDING", ptr.Of("RUNNING")))
+}
@@ -376,20 +376,40 @@ func GetSocket(path, appID, protocol string) string {
 	return fmt.Sprintf(socketFormat, path, appID, protocol)
 }
 
+// GetDefaultRegistry returns the registry set with DAPR_DEFAULT_IMAGE_REGISTRY
+// and prints where the container images will be pulled from.
 func GetDefaultRegistry(githubContainerRegistryName, dockerContainerRegistryName string) (string, error) {
+	registry, err := LookupDefaultRegistry(githubContainerRegistryName, dockerContainerRegistryName)
+	if err != nil {
+		return "", err
+	}
+	PrintDefaultRegistry(registry, githubContainerRegistryName)
+	return registry, nil
+}
+
+// LookupDefaultRegistry returns the registry set with
+// DAPR_DEFAULT_IMAGE_REGISTRY, which is Docker Hub by default.
+func LookupDefaultRegistry(githubContainerRegistryName, dockerContainerRegistryName string) (string, error) {
 	val := strings.ToLower(os.Getenv("DAPR_DEFAULT_IMAGE_REGISTRY"))
 	switch val {
 	case "":
-		print.InfoStatusEvent(os.Stdout, "Container images will be pulled from Docker Hub")
 		return dockerContainerRegistryName, nil
 	case githubContainerRegistryName:
-		print.InfoStatusEvent(os.Stdout, "Container images will be pulled from Dapr GitHub container registry")
 		return githubContainerRegistryName, nil
 	default:
 		return "", fmt.Errorf("environment variable %q can only be set to %s", "DAPR_DEFAULT_IMAGE_REGISTRY", "GHCR")
 	}
 }
 
+// PrintDefaultRegistry prints where the container images will be pulled from.
+func PrintDefaultRegistry(registry, githubContainerRegistryName string) {
+	if registry == githubContainerRegistryName {
+		print.InfoStatusEvent(os.Stdout, "Container images will be pulled from Dapr GitHub container registry")
+		return
+	}
+	print.InfoStatusEvent(os.Stdout, "Container images will be pulled from Docker Hub")
+}
+
 func ValidateImageVariant(imageVariant string) error {
 	if imageVariant != "" && imageVariant != marinerImageVariantName {
 		return fmt.Errorf("image variant %s is not supported", imageVariant)